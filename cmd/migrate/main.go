@@ -1,7 +1,83 @@
 package main
 
-import "fmt"
+import (
+	"database/sql"
+	"io/fs"
+	"log"
+	"sort"
+
+	"github.com/okanay/go-template/configs"
+	"github.com/okanay/go-template/migrations"
+	"github.com/okanay/go-template/pkg/database"
+)
 
 func main() {
-	fmt.Println("Go Project Start.")
+	// Migration sadece veritabanı ayarına ihtiyaç duyar; R2, JWT vb. eksik olsa da çalışır.
+	var dbConfig configs.DatabaseConfig
+	if err := configs.LoadInto(&dbConfig, ".env"); err != nil {
+		log.Fatalf("[MIGRATE::CONFIG] :: %v", err)
+	}
+
+	db, err := database.NewPostgres(dbConfig.MainConnString)
+	if err != nil {
+		log.Fatalf("[MIGRATE::ERROR] :: Failed to connect to database: %v", err)
+	}
+	defer db.Close()
+
+	// Uygulanan migration'ları takip eden tablo
+	if _, err := db.Exec(`CREATE TABLE IF NOT EXISTS schema_migrations (
+		version    TEXT PRIMARY KEY,
+		applied_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+	)`); err != nil {
+		log.Fatalf("[MIGRATE::ERROR] :: Failed to create schema_migrations: %v", err)
+	}
+
+	files, err := fs.Glob(migrations.FS, "*.sql")
+	if err != nil {
+		log.Fatalf("[MIGRATE::ERROR] :: Failed to list migrations: %v", err)
+	}
+	sort.Strings(files)
+
+	applied := 0
+	for _, name := range files {
+		var exists bool
+		if err := db.QueryRow(`SELECT EXISTS(SELECT 1 FROM schema_migrations WHERE version = $1)`, name).Scan(&exists); err != nil {
+			log.Fatalf("[MIGRATE::ERROR] :: Failed to check %s: %v", name, err)
+		}
+		if exists {
+			continue
+		}
+
+		if err := apply(db, name); err != nil {
+			log.Fatalf("[MIGRATE::ERROR] :: %s failed: %v", name, err)
+		}
+
+		log.Printf("[MIGRATE::SUCCESS] :: Applied %s", name)
+		applied++
+	}
+
+	log.Printf("[MIGRATE::INFO] :: Done, %d new migration(s) applied.", applied)
+}
+
+// apply - Tek bir migration dosyasını transaction içinde uygular.
+func apply(db *sql.DB, name string) error {
+	content, err := fs.ReadFile(migrations.FS, name)
+	if err != nil {
+		return err
+	}
+
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Exec(string(content)); err != nil {
+		return err
+	}
+	if _, err := tx.Exec(`INSERT INTO schema_migrations (version) VALUES ($1)`, name); err != nil {
+		return err
+	}
+
+	return tx.Commit()
 }
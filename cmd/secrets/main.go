@@ -0,0 +1,83 @@
+package main
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/joho/godotenv"
+	"github.com/okanay/go-template/configs"
+)
+
+// Şifreli env dosyası (.env.enc) yönetimi. Anahtar CONFIG_MASTER_KEY veya CONFIG_MASTER_KEY_FILE'dan okunur.
+//
+//	go run ./cmd/secrets keygen
+//	CONFIG_MASTER_KEY=... go run ./cmd/secrets encrypt .env.production .env.enc
+//	CONFIG_MASTER_KEY=... go run ./cmd/secrets decrypt .env.enc
+func main() {
+	if len(os.Args) < 2 {
+		usage()
+	}
+
+	switch os.Args[1] {
+	case "keygen":
+		key := make([]byte, 32)
+		if _, err := rand.Read(key); err != nil {
+			log.Fatalf("[SECRETS::ERROR] :: %v", err)
+		}
+		fmt.Println(hex.EncodeToString(key))
+
+	case "encrypt":
+		if len(os.Args) != 4 {
+			usage()
+		}
+		plain, err := os.ReadFile(os.Args[2])
+		if err != nil {
+			log.Fatalf("[SECRETS::ERROR] :: %v", err)
+		}
+		// Bozuk bir dosya şifrelenip deploy edilmesin; açılışta fark edilmesi geç olur
+		if _, err := godotenv.UnmarshalBytes(plain); err != nil {
+			log.Fatalf("[SECRETS::ERROR] :: %s is not a valid env file: %v", os.Args[2], err)
+		}
+
+		data, err := configs.EncryptEnv(plain, masterKey())
+		if err != nil {
+			log.Fatalf("[SECRETS::ERROR] :: %v", err)
+		}
+		if err := os.WriteFile(os.Args[3], data, 0o600); err != nil {
+			log.Fatalf("[SECRETS::ERROR] :: %v", err)
+		}
+		log.Printf("[SECRETS::SUCCESS] :: %s -> %s", os.Args[2], os.Args[3])
+
+	case "decrypt":
+		if len(os.Args) != 3 {
+			usage()
+		}
+		data, err := os.ReadFile(os.Args[2])
+		if err != nil {
+			log.Fatalf("[SECRETS::ERROR] :: %v", err)
+		}
+		plain, err := configs.DecryptEnv(data, masterKey())
+		if err != nil {
+			log.Fatalf("[SECRETS::ERROR] :: %v", err)
+		}
+		os.Stdout.Write(plain)
+
+	default:
+		usage()
+	}
+}
+
+func masterKey() []byte {
+	key, err := configs.MasterKey()
+	if err != nil {
+		log.Fatalf("[SECRETS::CONFIG] :: %v", err)
+	}
+	return key
+}
+
+func usage() {
+	log.Fatalf("usage: secrets keygen | encrypt <plain.env> <out.env.enc> | decrypt <file.env.enc>")
+}
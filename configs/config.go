@@ -0,0 +1,201 @@
+package configs
+
+import (
+	"time"
+
+	"github.com/okanay/go-template/pkg/logger"
+)
+
+// ═══════════════════════════════════════════════════════════════════
+// CONFIG
+// ═══════════════════════════════════════════════════════════════════
+// Uygulamanın tüm ayarları tek bir struct'ta toplanır ve açılışta Load ile doldurulur.
+// Alan tag'leri:
+//   - env:      Okunacak env değişkeni. Virgülle birden fazla isim verilirse ilk dolu olan
+//     kullanılır (eski isimler geriye dönük uyumluluk için ikinci sırada durur).
+//   - default:  Env boşsa kullanılacak değer.
+//   - validate: pkg/validator kuralları. Hatalı/eksik tüm değerler tek seferde raporlanır.
+//   - secret:   "true" ise String() / log çıktısında değer gizlenir.
+//   - reload:   Bölüm seviyesinde. "true" ise SIGHUP/dosya değişikliğinde restart olmadan
+//     güncellenir (bkz. Store). Diğer bölümlerdeki değişiklikler restart'a kadar uygulanmaz.
+//
+// Yeni ayar eklemek: ilgili bölüme alan + .env-example'a satır.
+
+type Config struct {
+	Server    ServerConfig
+	Network   NetworkConfig
+	CORS      CORSConfig      `reload:"true"`
+	Security  SecurityConfig  `reload:"true"`
+	RateLimit RateLimitConfig `reload:"true"`
+	Log       LogConfig       `reload:"true"`
+	Database  DatabaseConfig
+	Redis     RedisConfig
+	Auth      AuthConfig
+	Cookie    CookieConfig
+	Turnstile TurnstileConfig
+	R2        R2Config
+	Currency  CurrencyConfig
+	Webhook   WebhookConfig
+}
+
+type ServerConfig struct {
+	Port string `env:"PORT" default:"8080" validate:"required,numeric"`
+	// Mode - gin modu. Boşsa release: debug modunda açılışta Redis temizlendiği için
+	// production'da unutulmuş bir GIN_MODE'un veri kaybına yol açmaması gerekir.
+	Mode string `env:"GIN_MODE" default:"release" validate:"oneof=debug release test"`
+}
+
+type NetworkConfig struct {
+	TrustedProxies  []string `env:"TRUSTED_PROXIES" default:"127.0.0.1,::1"`
+	TrustCloudflare bool     `env:"TRUST_CLOUDFLARE" default:"true"`
+}
+
+type CORSConfig struct {
+	// AllowedOrigins - Tam origin ("https://mydomain.com"), subdomain pattern ("https://*.customer.com") veya "*".
+	AllowedOrigins []string `env:"CORS_ALLOWED_ORIGINS" default:"https://mydomain.com,https://www.mydomain.com,https://local.mydomain.com,https://www.local.mydomain.com"`
+	// DebugOrigins - Sadece GIN_MODE=debug'da AllowedOrigins'e eklenir.
+	DebugOrigins     []string      `env:"CORS_DEBUG_ORIGINS" default:"http://localhost:3000,http://127.0.0.1:3000"`
+	AllowMethods     []string      `env:"CORS_ALLOW_METHODS" default:"GET,PUT,POST,DELETE,HEAD,OPTIONS,PATCH"`
+	AllowHeaders     []string      `env:"CORS_ALLOW_HEADERS" default:"Content-Type,Authorization,Accept,Origin,X-Requested-With,Cache-Control,X-Language,X-Currency,X-CSRF-Token,Idempotency-Key,X-Captcha-Token"`
+	ExposeHeaders    []string      `env:"CORS_EXPOSE_HEADERS" default:"Content-Length,Content-Type,Content-Language,X-Currency,X-Request-Id,Retry-After"`
+	AllowCredentials bool          `env:"CORS_ALLOW_CREDENTIALS" default:"true"`
+	MaxAge           time.Duration `env:"CORS_MAX_AGE" default:"2h"`
+	// TenantOrigins - true ise statik listede olmayan origin'ler cors_origins tablosundan kontrol edilir.
+	TenantOrigins bool `env:"CORS_TENANT_ORIGINS" default:"false"`
+}
+
+// SecurityConfig - Güvenlik header'ları. Header'ı kapatmak için değer "off" verilir.
+type SecurityConfig struct {
+	// CSP - "{nonce}" her istekte yeniden üretilir, configs.CSPNonce(c) ile okunur.
+	CSP           string `env:"SECURITY_CSP" default:"default-src 'self'; script-src 'self' 'nonce-{nonce}'; style-src 'self' 'nonce-{nonce}'; object-src 'none'; base-uri 'self'; form-action 'self'; frame-ancestors 'none'"`
+	CSPReportOnly bool   `env:"SECURITY_CSP_REPORT_ONLY" default:"false"`
+	CSPReportURI  string `env:"SECURITY_CSP_REPORT_URI" default:"/csp-report"`
+	// HSTSMaxAge - 0 kapatır. Debug mode'da gönderilmez.
+	HSTSMaxAge                time.Duration `env:"SECURITY_HSTS_MAX_AGE" default:"8760h"`
+	HSTSIncludeSubdomains     bool          `env:"SECURITY_HSTS_INCLUDE_SUBDOMAINS" default:"true"`
+	HSTSPreload               bool          `env:"SECURITY_HSTS_PRELOAD" default:"false"`
+	PermissionsPolicy         string        `env:"SECURITY_PERMISSIONS_POLICY" default:"camera=(), microphone=(), geolocation=(), payment=(), usb=()"`
+	ReferrerPolicy            string        `env:"SECURITY_REFERRER_POLICY" default:"strict-origin-when-cross-origin"`
+	FrameOptions              string        `env:"SECURITY_FRAME_OPTIONS" default:"DENY"`
+	CrossOriginOpenerPolicy   string        `env:"SECURITY_COOP" default:"same-origin"`
+	CrossOriginEmbedderPolicy string        `env:"SECURITY_COEP" default:"off"`
+	CrossOriginResourcePolicy string        `env:"SECURITY_CORP" default:"same-site"`
+}
+
+// RateLimitConfig - Dakika başına istek limitleri (mw.RateLimit).
+type RateLimitConfig struct {
+	Auth      int `env:"RATE_LIMIT_AUTH" default:"10" validate:"min=1"`
+	Account   int `env:"RATE_LIMIT_ACCOUNT" default:"30" validate:"min=1"`
+	CSPReport int `env:"RATE_LIMIT_CSP_REPORT" default:"30" validate:"min=1"`
+}
+
+// LogConfig - Level reload'da logger.SetLevel ile uygulanır; Format sadece açılışta (logger.Init).
+type LogConfig struct {
+	Level  string `env:"LOG_LEVEL" default:"info" validate:"oneof=debug info warn error DEBUG INFO WARN ERROR"`
+	Format string `env:"LOG_FORMAT" validate:"omitempty,oneof=json text"`
+}
+
+type DatabaseConfig struct {
+	// DB_MAIN_CONN_STRING - Eski isim; sadece MAIN_CONN_STRING boşsa okunur.
+	MainConnString string `env:"MAIN_CONN_STRING,DB_MAIN_CONN_STRING" validate:"required" secret:"true"`
+}
+
+type RedisConfig struct {
+	Addr     string `env:"REDIS_ADDR" default:"localhost:6379" validate:"required,hostname_port"`
+	Username string `env:"REDIS_USERNAME"`
+	Password string `env:"REDIS_PASS" secret:"true"`
+	DB       int    `env:"REDIS_DB" default:"0" validate:"gte=0,lte=15"`
+}
+
+type AuthConfig struct {
+	JWTAccessSecret     string `env:"JWT_ACCESS_SECRET" validate:"required,min=32" secret:"true"`
+	TokenIssuer         string `env:"TOKEN_ISSUER" default:"MY_JWT_ISSUER_NAME" validate:"required"`
+	ReauthWindowMinutes int    `env:"REAUTH_WINDOW_MINUTES" default:"10" validate:"gte=1"`
+	SessionBackend      string `env:"SESSION_BACKEND" default:"jwt" validate:"oneof=jwt redis"`
+}
+
+type CookieConfig struct {
+	Domain string `env:"COOKIE_DOMAIN" default:"localhost" validate:"required"`
+	Secure bool   `env:"COOKIE_SECURE" default:"false"`
+}
+
+type TurnstileConfig struct {
+	SecretKey string   `env:"TURNSTILE_SECRET_KEY" secret:"true"`
+	Hostnames []string `env:"TURNSTILE_HOSTNAMES"`
+}
+
+type R2Config struct {
+	AccountID       string `env:"R2_ACCOUNT_ID"`
+	AccessKeyID     string `env:"R2_ACCESS_KEY_ID" validate:"required"`
+	AccessKeySecret string `env:"R2_ACCESS_KEY_SECRET" validate:"required" secret:"true"`
+	BucketName      string `env:"R2_BUCKET_NAME" validate:"required"`
+	FolderName      string `env:"R2_FOLDER_NAME" default:"uploads"`
+	// Endpoint - Boşsa R2_ACCOUNT_ID'den türetilir: https://<account>.r2.cloudflarestorage.com
+	Endpoint      string `env:"R2_ENDPOINT" validate:"required,url"`
+	PublicURLBase string `env:"R2_PUBLIC_URL_BASE" validate:"required,url"`
+}
+
+type CurrencyConfig struct {
+	Default     string `env:"DEFAULT_CURRENCY" default:"TRY" validate:"oneof=TRY EUR USD"`
+	Provider    string `env:"EXCHANGE_RATE_PROVIDER" default:"static" validate:"oneof=static ecb"`
+	RatesBase   string `env:"EXCHANGE_RATES_BASE" default:"EUR" validate:"oneof=TRY EUR USD"`
+	RatesStatic string `env:"EXCHANGE_RATES_STATIC" default:"USD=1.08,TRY=37.50"`
+}
+
+// WebhookConfig - Kaynak başına HMAC secret'ları. Rotation sırasında eski ve yeni secret virgülle birlikte verilir.
+// Yeni partner eklemek: alan + Secrets'a case + .env-example'a WEBHOOK_<SOURCE>_SECRETS.
+type WebhookConfig struct {
+	StripeSecrets  []string `env:"WEBHOOK_STRIPE_SECRETS" secret:"true"`
+	StorageSecrets []string `env:"WEBHOOK_STORAGE_SECRETS" secret:"true"`
+}
+
+// Secrets - middleware.WebhookFromConfig'in kaynak adına göre okuduğu secret'lar. Bilinmeyen kaynakta nil.
+func (w WebhookConfig) Secrets(source string) []string {
+	switch source {
+	case "stripe":
+		return w.StripeSecrets
+	case "storage":
+		return w.StorageSecrets
+	}
+	return nil
+}
+
+// IsDebug - GIN_MODE=debug
+func (c *Config) IsDebug() bool {
+	return c.Server.Mode == "debug"
+}
+
+// Logger - logger.Init ayarları. LOG_FORMAT boşsa debug mode'da text, diğer durumlarda json.
+func (c *Config) Logger() logger.Config {
+	format := logger.Format(c.Log.Format)
+	if format == "" {
+		format = logger.FormatJSON
+		if c.IsDebug() {
+			format = logger.FormatText
+		}
+	}
+	return logger.Config{Format: format, Level: logger.ParseLevel(c.Log.Level)}
+}
+
+// normalize - Türetilen değerler doğrulamadan önce doldurulur.
+func (c *Config) normalize() {
+	if c.R2.Endpoint == "" && c.R2.AccountID != "" {
+		c.R2.Endpoint = "https://" + c.R2.AccountID + ".r2.cloudflarestorage.com"
+	}
+}
+
+// check - validate tag'leriyle ifade edilemeyen kurallar.
+func (c *Config) check() []string {
+	var problems []string
+	for _, origin := range c.CORS.AllowedOrigins {
+		if err := ValidateOriginPattern(origin); err != nil {
+			problems = append(problems, "CORS_ALLOWED_ORIGINS: "+err.Error())
+		}
+	}
+	for _, origin := range c.CORS.DebugOrigins {
+		if err := ValidateOriginPattern(origin); err != nil {
+			problems = append(problems, "CORS_DEBUG_ORIGINS: "+err.Error())
+		}
+	}
+	return problems
+}
@@ -1,57 +1,188 @@
 package configs
 
 import (
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
 	"github.com/gin-contrib/cors"
 	"github.com/gin-gonic/gin"
 )
 
-func CorsConfig() gin.HandlerFunc {
-	var origins = []string{
-		// Production domains
-		"https://mydomain.com",
-		"https://www.mydomain.com",
-
-		// Local development
-		"https://local.mydomain.com",
-		"https://www.local.mydomain.com",
-	}
-
-	// Debug mode'da localhost ekle
-	if gin.Mode() == gin.DebugMode {
-		origins = append(origins,
-			"http://localhost:3000",
-			"http://127.0.0.1:3000",
-		)
-	}
-
-	return cors.New(cors.Config{
-		AllowOrigins: origins,
-		AllowMethods: []string{
-			"GET",
-			"PUT",
-			"POST",
-			"DELETE",
-			"HEAD",
-			"OPTIONS",
-			"PATCH",
-		},
-		AllowHeaders: []string{
-			"Content-Type",
-			"Authorization",
-			"Accept",
-			"Origin",
-			"X-Requested-With",
-			"Cache-Control",
-			"X-Language",
-			"X-Currency",
-			"X-CSRF-Token",
-		},
-		ExposeHeaders: []string{
-			"Content-Length",
-			"Content-Type",
-			"X-Request-Id",
-		},
-		AllowCredentials: true,
-		MaxAge:           60 * 24 * 30,
-	})
+// CORSPolicy - Bir route grubuna uygulanan CORS kuralları.
+type CORSPolicy struct {
+	// Origins - Tam origin, tek "*" içeren subdomain pattern'i ("https://*.customer.com") veya herkese açık "*".
+	Origins          []string
+	Methods          []string
+	Headers          []string
+	ExposeHeaders    []string
+	MaxAge           time.Duration
+	AllowCredentials bool
+	// AllowOrigin - Statik listede olmayan origin'ler için ek kontrol (örn: DB'deki tenant origin'leri).
+	AllowOrigin func(c *gin.Context, origin string) bool
+}
+
+// CORSOverride - PathPrefix ile başlayan isteklerde varsayılan policy yerine kullanılır.
+// Route grubu middleware'i yerine global seçilir, çünkü preflight (OPTIONS) istekleri grup route'larına düşmez.
+type CORSOverride struct {
+	PathPrefix string
+	Policy     CORSPolicy
+}
+
+// CORSPolicy - Config'deki varsayılan policy. Debug mode'da DebugOrigins eklenir.
+func (c *Config) CORSPolicy() CORSPolicy {
+	origins := append([]string(nil), c.CORS.AllowedOrigins...)
+	if c.IsDebug() {
+		origins = append(origins, c.CORS.DebugOrigins...)
+	}
+	return CORSPolicy{
+		Origins:          origins,
+		Methods:          c.CORS.AllowMethods,
+		Headers:          c.CORS.AllowHeaders,
+		ExposeHeaders:    c.CORS.ExposeHeaders,
+		MaxAge:           c.CORS.MaxAge,
+		AllowCredentials: c.CORS.AllowCredentials,
+	}
+}
+
+// PublicCORSPolicy - Herkese açık, sadece okunan endpoint'ler için (embed, widget).
+// Cookie/Authorization taşınmaz.
+func (c *Config) PublicCORSPolicy() CORSPolicy {
+	return CORSPolicy{
+		Origins:       []string{"*"},
+		Methods:       []string{"GET", "HEAD", "OPTIONS"},
+		Headers:       []string{"Accept", "Content-Type", "X-Language", "X-Currency"},
+		ExposeHeaders: c.CORS.ExposeHeaders,
+		MaxAge:        c.CORS.MaxAge,
+	}
+}
+
+// Cors - Path'e göre en uzun eşleşen override'ın, yoksa varsayılan policy'nin CORS handler'ını çalıştırır.
+func Cors(policy CORSPolicy, overrides ...CORSOverride) gin.HandlerFunc {
+	prefixes := make([]string, len(overrides))
+	handlers := make([]gin.HandlerFunc, len(overrides))
+	for i, o := range overrides {
+		prefixes[i] = o.PathPrefix
+		handlers[i] = o.Policy.handler()
+	}
+	base := policy.handler()
+
+	return func(c *gin.Context) {
+		if i := matchPrefix(c.Request.URL.Path, prefixes); i >= 0 {
+			handlers[i](c)
+			return
+		}
+		base(c)
+	}
+}
+
+// matchPrefix - Path'i kapsayan en uzun prefix'in index'i, yoksa -1.
+// "/currency" prefix'i "/currency/rates" ile eşleşir, "/currencyx" ile eşleşmez.
+func matchPrefix(path string, prefixes []string) int {
+	best := -1
+	for i, prefix := range prefixes {
+		prefix = strings.TrimSuffix(prefix, "/")
+		if path != prefix && !strings.HasPrefix(path, prefix+"/") {
+			continue
+		}
+		if best < 0 || len(prefix) > len(strings.TrimSuffix(prefixes[best], "/")) {
+			best = i
+		}
+	}
+	return best
+}
+
+func (p CORSPolicy) handler() gin.HandlerFunc {
+	for _, origin := range p.Origins {
+		if err := ValidateOriginPattern(origin); err != nil {
+			panic(err)
+		}
+	}
+
+	cfg := cors.Config{
+		AllowMethods:     p.Methods,
+		AllowHeaders:     p.Headers,
+		ExposeHeaders:    p.ExposeHeaders,
+		AllowCredentials: p.AllowCredentials,
+		MaxAge:           p.MaxAge,
+	}
+
+	allowAll := false
+	for _, origin := range p.Origins {
+		if origin == "*" {
+			allowAll = true
+		}
+	}
+	if allowAll {
+		// "*" ile credential gönderilmesi tarayıcılar tarafından reddedilir; herkese açık policy'de kapatılır.
+		cfg.AllowAllOrigins = true
+		cfg.AllowCredentials = false
+		return cors.New(cfg)
+	}
+
+	patterns := make([]string, len(p.Origins))
+	for i, origin := range p.Origins {
+		patterns[i] = strings.ToLower(origin)
+	}
+	cfg.AllowOriginWithContextFunc = func(c *gin.Context, origin string) bool {
+		lower := strings.ToLower(origin)
+		for _, pattern := range patterns {
+			if MatchOrigin(pattern, lower) {
+				return true
+			}
+		}
+		return p.AllowOrigin != nil && p.AllowOrigin(c, origin)
+	}
+	return cors.New(cfg)
+}
+
+// MatchOrigin - Origin'i tam değer veya "https://*.customer.com" pattern'i ile karşılaştırır.
+// "*" bir veya daha fazla subdomain label'ına karşılık gelir; "customer.com"un kendisi eşleşmez.
+func MatchOrigin(pattern, origin string) bool {
+	pattern, origin = strings.ToLower(pattern), strings.ToLower(origin)
+	if pattern == origin {
+		return true
+	}
+	prefix, suffix, ok := strings.Cut(pattern, "*")
+	if !ok || len(origin) <= len(prefix)+len(suffix) {
+		return false
+	}
+	if !strings.HasPrefix(origin, prefix) || !strings.HasSuffix(origin, suffix) {
+		return false
+	}
+
+	// Ara kısım sadece host karakterleri içerebilir: "https://x.com/.customer.com" gibi değerler eşleşmez
+	label := origin[len(prefix) : len(origin)-len(suffix)]
+	if strings.HasPrefix(label, ".") || strings.HasPrefix(label, "-") {
+		return false
+	}
+	for _, r := range label {
+		if !(r >= 'a' && r <= 'z' || r >= '0' && r <= '9' || r == '-' || r == '.') {
+			return false
+		}
+	}
+	return true
+}
+
+// ValidateOriginPattern - Origin veya pattern'in scheme://host[:port] formatında olduğunu kontrol eder.
+func ValidateOriginPattern(origin string) error {
+	if origin == "*" {
+		return nil
+	}
+	if strings.Count(origin, "*") > 1 {
+		return fmt.Errorf("invalid origin %q: only one wildcard is allowed", origin)
+	}
+	if strings.Contains(origin, "*") && !strings.Contains(origin, "://*.") {
+		return fmt.Errorf("invalid origin %q: wildcard must be the leftmost subdomain label (https://*.example.com)", origin)
+	}
+
+	u, err := url.Parse(strings.Replace(origin, "*", "wildcard", 1))
+	if err != nil || u.Host == "" || (u.Scheme != "http" && u.Scheme != "https") {
+		return fmt.Errorf("invalid origin %q: expected scheme://host[:port]", origin)
+	}
+	if u.Path != "" || u.RawQuery != "" || u.Fragment != "" || u.User != nil {
+		return fmt.Errorf("invalid origin %q: origin must not contain a path, query or credentials", origin)
+	}
+	return nil
 }
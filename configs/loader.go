@@ -0,0 +1,268 @@
+package configs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"log/slog"
+	"os"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/joho/godotenv"
+	"github.com/okanay/go-template/pkg/logger"
+	validation "github.com/okanay/go-template/pkg/validator"
+)
+
+// LoadError - Eksik veya hatalı tüm değerler. Uygulama ilk hatada değil, listenin tamamını
+// gösterip durur; deploy sırasında tek tek düzeltip tekrar denemek gerekmez.
+type LoadError struct {
+	Problems []string
+}
+
+func (e *LoadError) Error() string {
+	return "invalid configuration:\n  - " + strings.Join(e.Problems, "\n  - ")
+}
+
+// Load - Config'i sırasıyla env, NAME_FILE, SecretProviders (.env.enc), dosyalar (.env) ve
+// default'lardan doldurur ve doğrular.
+// Sistem env'i dosyalardaki değerleri ezer (Docker/Kubernetes'te verilen değer geçerlidir).
+// Bulunamayan dosyalar atlanır; production'da .env olmaması normaldir.
+func Load(files ...string) (*Config, error) {
+	cfg := &Config{}
+	if err := LoadInto(cfg, files...); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
+// LoadInto - Tek bir bölümü yüklemek için (örn: cmd/migrate sadece DatabaseConfig ister).
+// Process env'i değiştirilmez; değerler önce bir map'te çözülür, target sadece bu map'ten doldurulur.
+// Reload reddedilirse veya restart gerektirirse çalışan kod hiçbir değişiklik görmez.
+func LoadInto(target any, files ...string) error {
+	env, err := loadSources(files)
+	if err != nil {
+		return err
+	}
+
+	var problems []string
+	fill(reflect.ValueOf(target).Elem(), env, &problems)
+
+	if n, ok := target.(interface{ normalize() }); ok {
+		n.normalize()
+	}
+
+	for _, v := range validation.New().Validate(target) {
+		problems = append(problems, v.Message)
+	}
+	if c, ok := target.(interface{ check() []string }); ok {
+		problems = append(problems, c.check()...)
+	}
+
+	if len(problems) > 0 {
+		return &LoadError{Problems: problems}
+	}
+	return nil
+}
+
+// loadSources - Sistem env'i, NAME_FILE, SecretProviders ve .env dosyalarını öncelik sırasıyla
+// tek bir map'te birleştirir. Kaynaklardan biri okunamazsa hiçbir değer dönmez.
+func loadSources(files []string) (map[string]string, error) {
+	fileValues, err := readEnvFiles(files)
+	if err != nil {
+		return nil, err
+	}
+
+	values, err := fileSecrets(fileValues)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	for _, provider := range SecretProviders {
+		secrets, err := provider.Secrets(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("secret provider %s: %w", provider.Name(), err)
+		}
+		for key, value := range secrets {
+			if _, exists := values[key]; !exists {
+				values[key] = value
+			}
+		}
+	}
+
+	for key, value := range fileValues {
+		if _, exists := values[key]; !exists {
+			values[key] = value
+		}
+	}
+
+	// Sistem env'i her zaman kazanır (Docker/Kubernetes'te verilen değer geçerlidir)
+	for _, kv := range os.Environ() {
+		key, value, _ := strings.Cut(kv, "=")
+		values[key] = value
+	}
+	return values, nil
+}
+
+// readEnvFiles - .env dosyalarını birleştirir; aynı anahtarda önce verilen dosya kazanır.
+func readEnvFiles(files []string) (map[string]string, error) {
+	fileValues := map[string]string{}
+	for _, file := range files {
+		values, err := godotenv.Read(file)
+		if errors.Is(err, fs.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("config file %s: %w", file, err)
+		}
+		for key, value := range values {
+			if _, exists := fileValues[key]; !exists {
+				fileValues[key] = value
+			}
+		}
+	}
+	return fileValues, nil
+}
+
+func fill(v reflect.Value, env map[string]string, problems *[]string) {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field, value := t.Field(i), v.Field(i)
+
+		if field.Type.Kind() == reflect.Struct {
+			fill(value, env, problems)
+			continue
+		}
+
+		names := field.Tag.Get("env")
+		if names == "" {
+			continue
+		}
+
+		raw, name := lookup(env, names)
+		if raw == "" {
+			raw = field.Tag.Get("default")
+		}
+		if raw == "" {
+			continue
+		}
+
+		if err := set(value, raw); err != nil {
+			*problems = append(*problems, fmt.Sprintf("%s: %v", name, err))
+		}
+	}
+}
+
+// lookup - İlk dolu env değişkenini döner. Hiçbiri dolu değilse ilk (asıl) ismi.
+func lookup(env map[string]string, names string) (string, string) {
+	list := strings.Split(names, ",")
+	for _, name := range list {
+		if value := strings.TrimSpace(env[name]); value != "" {
+			return value, name
+		}
+	}
+	return "", list[0]
+}
+
+var durationType = reflect.TypeOf(time.Duration(0))
+
+func set(v reflect.Value, raw string) error {
+	if v.Type() == durationType {
+		d, err := time.ParseDuration(raw)
+		if err != nil {
+			return fmt.Errorf("invalid duration %q", raw)
+		}
+		v.SetInt(int64(d))
+		return nil
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(raw)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(raw)
+		if err != nil {
+			return fmt.Errorf("invalid boolean %q", raw)
+		}
+		v.SetBool(b)
+	case reflect.Int, reflect.Int64:
+		n, err := strconv.ParseInt(raw, 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid integer %q", raw)
+		}
+		v.SetInt(n)
+	case reflect.Slice:
+		var items []string
+		for _, item := range strings.Split(raw, ",") {
+			if item = strings.TrimSpace(item); item != "" {
+				items = append(items, item)
+			}
+		}
+		v.Set(reflect.ValueOf(items))
+	default:
+		return fmt.Errorf("unsupported config type %s", v.Type())
+	}
+	return nil
+}
+
+// ─── Redaction ───────────────────────────────────────────────────
+
+// String - fmt ile yazdırıldığında secret:"true" alanlar [REDACTED] olur.
+func (c Config) String() string {
+	var sb strings.Builder
+	walk(reflect.ValueOf(c), func(section, name string, value any) {
+		fmt.Fprintf(&sb, "%s.%s=%v\n", section, name, value)
+	})
+	return sb.String()
+}
+
+// LogValue - mainLog.Info("config loaded", "config", cfg) çıktısında secret'lar görünmez.
+func (c Config) LogValue() slog.Value {
+	groups := map[string][]any{}
+	var order []string
+	walk(reflect.ValueOf(c), func(section, name string, value any) {
+		if _, ok := groups[section]; !ok {
+			order = append(order, section)
+		}
+		groups[section] = append(groups[section], slog.Any(name, value))
+	})
+
+	attrs := make([]slog.Attr, 0, len(order))
+	for _, section := range order {
+		attrs = append(attrs, slog.Group(section, groups[section]...))
+	}
+	return slog.GroupValue(attrs...)
+}
+
+func walk(v reflect.Value, visit func(section, name string, value any)) {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		section := t.Field(i)
+		if section.Type.Kind() != reflect.Struct {
+			continue
+		}
+		sv := v.Field(i)
+		for j := 0; j < section.Type.NumField(); j++ {
+			field := section.Type.Field(j)
+			name, _, _ := strings.Cut(field.Tag.Get("env"), ",")
+			if name == "" {
+				continue
+			}
+
+			value := sv.Field(j).Interface()
+			if field.Tag.Get("secret") == "true" {
+				if sv.Field(j).IsZero() {
+					value = ""
+				} else {
+					value = logger.Redacted
+				}
+			}
+			visit(strings.ToLower(section.Name), name, value)
+		}
+	}
+}
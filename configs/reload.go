@@ -0,0 +1,189 @@
+package configs
+
+import (
+	"context"
+	"errors"
+	"os"
+	"os/signal"
+	"reflect"
+	"sync"
+	"sync/atomic"
+	"syscall"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/okanay/go-template/pkg/logger"
+)
+
+// Store - Çalışma anında yenilenebilen config.
+// Reload yeni config'i doğrular, geçerliyse atomik olarak değiştirir ve subscriber'lara bildirir.
+// Geçersiz bir değişiklik loglanır, önceki config kullanılmaya devam eder.
+type Store struct {
+	files       []string
+	current     atomic.Pointer[Config]
+	mu          sync.Mutex // Reload ve subscriber listesi
+	subscribers []func(old, new *Config)
+}
+
+// NewStore - Load ile yüklenmiş config'i ve reload'da tekrar okunacak dosyaları alır.
+func NewStore(cfg *Config, files ...string) *Store {
+	s := &Store{files: files}
+	s.current.Store(cfg)
+	return s
+}
+
+// Current - Her istekte çağrılabilir; kilit almaz.
+func (s *Store) Current() *Config {
+	return s.current.Load()
+}
+
+// Subscribe - Başarılı her reload'dan sonra çağrılır. Sıra, kayıt sırasıdır.
+func (s *Store) Subscribe(fn func(old, new *Config)) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.subscribers = append(s.subscribers, fn)
+}
+
+// Reload - Env ve dosyaları yeniden okur. reload:"true" olmayan bölümler eski değerinde kalır,
+// değişmişlerse restart gerektiği loglanır (DB bağlantısı, port vb. çalışırken değiştirilemez).
+func (s *Store) Reload() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	log := logger.Component("config")
+	next, err := Load(s.files...)
+	if err != nil {
+		var loadErr *LoadError
+		if errors.As(err, &loadErr) {
+			log.Error("config reload rejected, keeping previous configuration", "problems", loadErr.Problems)
+		} else {
+			log.Error("config reload rejected, keeping previous configuration", "error", err)
+		}
+		return err
+	}
+
+	prev := s.Current()
+	var changed, restart []string
+	pv, nv := reflect.ValueOf(prev).Elem(), reflect.ValueOf(next).Elem()
+	for i := 0; i < pv.NumField(); i++ {
+		section := pv.Type().Field(i)
+		if reflect.DeepEqual(pv.Field(i).Interface(), nv.Field(i).Interface()) {
+			continue
+		}
+		if section.Tag.Get("reload") == "true" {
+			changed = append(changed, section.Name)
+			continue
+		}
+		restart = append(restart, section.Name)
+		nv.Field(i).Set(pv.Field(i))
+	}
+
+	if len(restart) > 0 {
+		log.Warn("config changes require a restart and were not applied", "sections", restart)
+	}
+	if len(changed) == 0 {
+		log.Info("config reloaded, no changes")
+		return nil
+	}
+
+	s.current.Store(next)
+	for _, fn := range s.subscribers {
+		s.notify(fn, prev, next)
+	}
+	log.Info("config reloaded", "sections", changed, "config", next)
+	return nil
+}
+
+// notify - Bir subscriber'ın panic'i diğerlerini ve reload goroutine'ini durdurmaz.
+func (s *Store) notify(fn func(old, new *Config), prev, next *Config) {
+	defer func() {
+		if r := recover(); r != nil {
+			logger.Component("config").Error("config subscriber panicked", "panic", r)
+		}
+	}()
+	fn(prev, next)
+}
+
+// Watch - SIGHUP geldiğinde veya dosyalardan biri değiştiğinde (interval'da bir kontrol) Reload çağırır.
+// İzlenen dosyalar: .env dosyaları, NAME_FILE ile mount edilen secret'lar ve FileProvider'ların
+// dosyaları (.env.enc, master key). Uzak SecretProvider'lar sadece SIGHUP ile yenilenir.
+// ctx iptal edilene kadar bloklar; go store.Watch(ctx, 5*time.Second) ile çalıştırılır.
+func (s *Store) Watch(ctx context.Context, interval time.Duration) {
+	hup := make(chan os.Signal, 1)
+	signal.Notify(hup, syscall.SIGHUP)
+	defer signal.Stop(hup)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	// Kubernetes ConfigMap'leri symlink değiştirerek günceller; os.Stat hedefi takip eder
+	stamps := s.stamps()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-hup:
+			logger.Component("config").Info("SIGHUP received, reloading configuration")
+			stamps = s.stamps()
+			s.Reload()
+		case <-ticker.C:
+			if next := s.stamps(); !reflect.DeepEqual(next, stamps) {
+				stamps = next
+				logger.Component("config").Info("config file changed, reloading configuration")
+				s.Reload()
+			}
+		}
+	}
+}
+
+type fileStamp struct {
+	modTime time.Time
+	size    int64
+}
+
+func (s *Store) stamps() map[string]fileStamp {
+	stamps := make(map[string]fileStamp, len(s.files))
+	for _, file := range s.watchedFiles() {
+		if info, err := os.Stat(file); err == nil {
+			stamps[file] = fileStamp{modTime: info.ModTime(), size: info.Size()}
+		}
+	}
+	return stamps
+}
+
+// watchedFiles - NAME_FILE yolları .env'de de tanımlanabildiği için her kontrolde yeniden çözülür.
+func (s *Store) watchedFiles() []string {
+	files := append([]string{}, s.files...)
+	if fileValues, err := readEnvFiles(s.files); err == nil {
+		for _, path := range fileSecretPaths(fileValues) {
+			files = append(files, path)
+		}
+	}
+	for _, provider := range SecretProviders {
+		if fp, ok := provider.(FileProvider); ok {
+			files = append(files, fp.Files()...)
+		}
+	}
+	return files
+}
+
+// Dynamic - Config'e bağlı bir middleware'i her reload'da yeniden kurar.
+// İstek sırasında handler değişirse o istek eski handler ile tamamlanır.
+//
+//	router.Use(configs.Dynamic(store, func(cfg *configs.Config) gin.HandlerFunc {
+//		return configs.Cors(cfg.CORSPolicy())
+//	}))
+func Dynamic(s *Store, build func(cfg *Config) gin.HandlerFunc) gin.HandlerFunc {
+	var handler atomic.Pointer[gin.HandlerFunc]
+	h := build(s.Current())
+	handler.Store(&h)
+
+	s.Subscribe(func(_, next *Config) {
+		h := build(next)
+		handler.Store(&h)
+	})
+
+	return func(c *gin.Context) {
+		(*handler.Load())(c)
+	}
+}
@@ -0,0 +1,236 @@
+package configs
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"reflect"
+	"strings"
+
+	"github.com/joho/godotenv"
+)
+
+// SecretProvider - Env'de olmayan değerleri harici bir kaynaktan çözer.
+// Load ve her reload'da sorulur; dönen değerler sadece Config'i doldurur, process env'ine yazılmaz.
+// Vault gibi bir backend eklemek: Secrets'ı KV okuması ile implement edip SecretProviders'a eklemek.
+type SecretProvider interface {
+	Name() string
+	Secrets(ctx context.Context) (map[string]string, error)
+}
+
+// SecretProviders - Sırayla sorulur; aynı anahtarı ilk dönen kazanır.
+// Öncelik: sistem env'i > NAME_FILE > SecretProviders > .env dosyaları.
+var SecretProviders = []SecretProvider{
+	EncryptedFile(".env.enc"),
+}
+
+// MasterKeyEnv - .env.enc'i çözen anahtar. Hex (64 karakter) veya base64 (32 byte).
+// Kubernetes/Docker'da CONFIG_MASTER_KEY_FILE ile mount edilmiş dosyadan okunur.
+// .env'e yazılamaz: şifreli dosyanın yanında düz metin anahtar tutulmamalı.
+const MasterKeyEnv = "CONFIG_MASTER_KEY"
+
+// encryptedPrefix - Format versiyonu. Değişirse eski dosyalar açık bir hata ile reddedilir.
+const encryptedPrefix = "enc:v1:"
+
+var (
+	ErrMissingMasterKey = errors.New(MasterKeyEnv + " is not set")
+	ErrInvalidMasterKey = errors.New(MasterKeyEnv + " must be 32 bytes (64 hex characters or base64)")
+	ErrDecrypt          = errors.New("encrypted env file could not be decrypted (wrong key or corrupted file)")
+)
+
+// ─── NAME_FILE ───────────────────────────────────────────────────
+
+// fileSecrets - JWT_ACCESS_SECRET_FILE=/run/secrets/jwt gibi değişkenlerin gösterdiği dosyaları okur.
+// Asıl değişken env'de zaten varsa dosya okunmaz. Sondaki satır sonu atılır.
+func fileSecrets(fileValues map[string]string) (map[string]string, error) {
+	secrets := map[string]string{}
+	for name, path := range fileSecretPaths(fileValues) {
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("%s_FILE: %w", name, err)
+		}
+		secrets[name] = strings.TrimRight(string(content), "\r\n")
+	}
+	return secrets, nil
+}
+
+// fileSecretPaths - Çözülecek NAME_FILE değişkenleri: isim -> dosya yolu. Store.Watch da bu
+// dosyaları izler; Kubernetes secret rotation'ı SIGHUP beklemeden reload tetikler.
+// Sadece Config'te env tag'i olan isimler çözülür; SSL_CERT_FILE, LOG_FILE gibi başka araçlara ait
+// değişkenler secret sayılmaz. CONFIG_MASTER_KEY_FILE'ı sadece MasterKey okur, Config'e girmez.
+func fileSecretPaths(fileValues map[string]string) map[string]string {
+	names := map[string]bool{}
+	envNames(reflect.TypeOf(Config{}), names)
+
+	candidates := map[string]string{}
+	for key, value := range fileValues {
+		candidates[key] = value
+	}
+	for _, kv := range os.Environ() {
+		key, value, _ := strings.Cut(kv, "=")
+		candidates[key] = value
+	}
+
+	paths := map[string]string{}
+	for key, path := range candidates {
+		name, ok := strings.CutSuffix(key, "_FILE")
+		if !ok || !names[name] || name == MasterKeyEnv || path == "" {
+			continue
+		}
+		if _, exists := os.LookupEnv(name); exists {
+			continue
+		}
+		paths[name] = path
+	}
+	return paths
+}
+
+// envNames - Struct'taki (iç içe bölümler dahil) tüm env tag isimleri ve eski alias'ları.
+func envNames(t reflect.Type, names map[string]bool) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if field.Type.Kind() == reflect.Struct {
+			envNames(field.Type, names)
+			continue
+		}
+		for _, name := range strings.Split(field.Tag.Get("env"), ",") {
+			if name = strings.TrimSpace(name); name != "" {
+				names[name] = true
+			}
+		}
+	}
+}
+
+// ─── Encrypted env file ──────────────────────────────────────────
+
+// FileProvider - Secret'ları yerel dosyalardan okuyan provider'lar. Store.Watch bu dosyaları da
+// izler; değişince SIGHUP beklemeden reload edilir. Uzak backend'ler (Vault vb.) sadece SIGHUP ile yenilenir.
+type FileProvider interface {
+	Files() []string
+}
+
+type encryptedFile struct {
+	path string
+}
+
+// EncryptedFile - AES-256-GCM ile şifrelenmiş .env dosyası (go run ./cmd/secrets encrypt).
+// Dosya yoksa boş döner; anahtar yoksa veya çözülemiyorsa hata döner.
+func EncryptedFile(path string) SecretProvider {
+	return encryptedFile{path: path}
+}
+
+func (f encryptedFile) Name() string {
+	return "file:" + f.path
+}
+
+// Files - Şifreli dosya ve (mount edilmişse) master key dosyası; ikisi de rotation'da değişir.
+func (f encryptedFile) Files() []string {
+	files := []string{f.path}
+	if path := os.Getenv(MasterKeyEnv + "_FILE"); path != "" {
+		files = append(files, path)
+	}
+	return files
+}
+
+func (f encryptedFile) Secrets(_ context.Context) (map[string]string, error) {
+	data, err := os.ReadFile(f.path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := MasterKey()
+	if err != nil {
+		return nil, err
+	}
+	plain, err := DecryptEnv(data, key)
+	if err != nil {
+		return nil, err
+	}
+	return godotenv.UnmarshalBytes(plain)
+}
+
+// MasterKey - CONFIG_MASTER_KEY veya CONFIG_MASTER_KEY_FILE'ın gösterdiği dosya.
+func MasterKey() ([]byte, error) {
+	raw := strings.TrimSpace(os.Getenv(MasterKeyEnv))
+	if path := os.Getenv(MasterKeyEnv + "_FILE"); raw == "" && path != "" {
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("%s_FILE: %w", MasterKeyEnv, err)
+		}
+		raw = strings.TrimSpace(string(content))
+	}
+	if raw == "" {
+		return nil, ErrMissingMasterKey
+	}
+	return ParseMasterKey(raw)
+}
+
+func ParseMasterKey(raw string) ([]byte, error) {
+	if key, err := hex.DecodeString(raw); err == nil && len(key) == 32 {
+		return key, nil
+	}
+	if key, err := base64.StdEncoding.DecodeString(raw); err == nil && len(key) == 32 {
+		return key, nil
+	}
+	return nil, ErrInvalidMasterKey
+}
+
+// EncryptEnv - Çıktı: "enc:v1:" + base64(nonce || ciphertext). Metin olduğu için diff/commit edilebilir.
+func EncryptEnv(plain, key []byte) ([]byte, error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	sealed := gcm.Seal(nonce, nonce, plain, []byte(encryptedPrefix))
+	return []byte(encryptedPrefix + base64.StdEncoding.EncodeToString(sealed) + "\n"), nil
+}
+
+func DecryptEnv(data, key []byte) ([]byte, error) {
+	text, ok := strings.CutPrefix(strings.TrimSpace(string(data)), encryptedPrefix)
+	if !ok {
+		return nil, fmt.Errorf("unsupported encrypted env format, expected %q prefix", encryptedPrefix)
+	}
+	sealed, err := base64.StdEncoding.DecodeString(text)
+	if err != nil {
+		return nil, ErrDecrypt
+	}
+
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(sealed) < gcm.NonceSize() {
+		return nil, ErrDecrypt
+	}
+	plain, err := gcm.Open(nil, sealed[:gcm.NonceSize()], sealed[gcm.NonceSize():], []byte(encryptedPrefix))
+	if err != nil {
+		return nil, ErrDecrypt
+	}
+	return plain, nil
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	if len(key) != 32 {
+		return nil, ErrInvalidMasterKey
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
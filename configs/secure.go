@@ -1,10 +1,168 @@
 package configs
 
-import "github.com/gin-contrib/secure"
-
-var SecureConfig = secure.New(secure.Config{
-	FrameDeny:             true,
-	ContentTypeNosniff:    true,
-	BrowserXssFilter:      true,
-	ContentSecurityPolicy: "default-src 'self'",
-})
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// CSPNonceKey - Gin context key. Handler'lar inline <script nonce="..."> için CSPNonce(c) kullanır.
+const CSPNonceKey = "cspNonce"
+
+// cspReportGroup - Reporting-Endpoints header'ındaki endpoint adı (CSP'de report-to ile eşleşir).
+const cspReportGroup = "csp-endpoint"
+
+// SecurityPolicy - Bir route grubuna uygulanan güvenlik header'ları. Boş alan header'ı göndermez.
+type SecurityPolicy struct {
+	// CSP - "{nonce}" her istekte üretilen nonce ile değiştirilir.
+	CSP                       string
+	CSPReportOnly             bool
+	ReportURI                 string // Boş değilse CSP'ye report-uri/report-to eklenir
+	HSTSMaxAge                time.Duration
+	HSTSIncludeSubdomains     bool
+	HSTSPreload               bool
+	PermissionsPolicy         string
+	ReferrerPolicy            string
+	FrameOptions              string
+	CrossOriginOpenerPolicy   string
+	CrossOriginEmbedderPolicy string
+	CrossOriginResourcePolicy string
+}
+
+// SecurityOverride - PathPrefix ile başlayan isteklerde varsayılan policy yerine kullanılır.
+type SecurityOverride struct {
+	PathPrefix string
+	Policy     SecurityPolicy
+}
+
+// SecurityPolicy - Config'deki varsayılan policy. Debug mode'da HSTS gönderilmez
+// (localhost'a yazılan HSTS tarayıcıda diğer lokal projeleri de https'e zorlar).
+func (c *Config) SecurityPolicy() SecurityPolicy {
+	s := c.Security
+	policy := SecurityPolicy{
+		CSP:                       off(s.CSP),
+		CSPReportOnly:             s.CSPReportOnly,
+		ReportURI:                 off(s.CSPReportURI),
+		HSTSMaxAge:                s.HSTSMaxAge,
+		HSTSIncludeSubdomains:     s.HSTSIncludeSubdomains,
+		HSTSPreload:               s.HSTSPreload,
+		PermissionsPolicy:         off(s.PermissionsPolicy),
+		ReferrerPolicy:            off(s.ReferrerPolicy),
+		FrameOptions:              off(s.FrameOptions),
+		CrossOriginOpenerPolicy:   off(s.CrossOriginOpenerPolicy),
+		CrossOriginEmbedderPolicy: off(s.CrossOriginEmbedderPolicy),
+		CrossOriginResourcePolicy: off(s.CrossOriginResourcePolicy),
+	}
+	if c.IsDebug() {
+		policy.HSTSMaxAge = 0
+	}
+	return policy
+}
+
+// PublicSecurityPolicy - Başka sitelerden gömülen/okunan endpoint'ler için (CORS PublicCORSPolicy ile birlikte).
+func (c *Config) PublicSecurityPolicy() SecurityPolicy {
+	policy := c.SecurityPolicy()
+	policy.CrossOriginResourcePolicy = "cross-origin"
+	return policy
+}
+
+// off - Env'de boş değer varsayılana döndüğü için header'ı kapatmak "off" ile yapılır.
+func off(value string) string {
+	if strings.EqualFold(value, "off") {
+		return ""
+	}
+	return value
+}
+
+// Secure - Path'e göre en uzun eşleşen override'ın, yoksa varsayılan policy'nin header'larını yazar.
+func Secure(policy SecurityPolicy, overrides ...SecurityOverride) gin.HandlerFunc {
+	prefixes := make([]string, len(overrides))
+	handlers := make([]gin.HandlerFunc, len(overrides))
+	for i, o := range overrides {
+		prefixes[i] = o.PathPrefix
+		handlers[i] = o.Policy.handler()
+	}
+	base := policy.handler()
+
+	return func(c *gin.Context) {
+		if i := matchPrefix(c.Request.URL.Path, prefixes); i >= 0 {
+			handlers[i](c)
+			return
+		}
+		base(c)
+	}
+}
+
+// CSPNonce - İsteğin CSP nonce'u. Policy'de "{nonce}" yoksa boş döner.
+func CSPNonce(c *gin.Context) string {
+	return c.GetString(CSPNonceKey)
+}
+
+func (p SecurityPolicy) handler() gin.HandlerFunc {
+	headers := map[string]string{
+		"X-Content-Type-Options": "nosniff",
+		// Eski XSS filtresi bilgi sızıntısına yol açabiliyor; modern tarayıcılarda CSP yeterli
+		"X-XSS-Protection":             "0",
+		"X-Frame-Options":              p.FrameOptions,
+		"Referrer-Policy":              p.ReferrerPolicy,
+		"Permissions-Policy":           p.PermissionsPolicy,
+		"Cross-Origin-Opener-Policy":   p.CrossOriginOpenerPolicy,
+		"Cross-Origin-Embedder-Policy": p.CrossOriginEmbedderPolicy,
+		"Cross-Origin-Resource-Policy": p.CrossOriginResourcePolicy,
+	}
+	if p.HSTSMaxAge > 0 {
+		hsts := "max-age=" + strconv.Itoa(int(p.HSTSMaxAge.Seconds()))
+		if p.HSTSIncludeSubdomains {
+			hsts += "; includeSubDomains"
+		}
+		if p.HSTSPreload {
+			hsts += "; preload"
+		}
+		headers["Strict-Transport-Security"] = hsts
+	}
+
+	csp := p.CSP
+	if csp != "" && p.ReportURI != "" {
+		csp = strings.TrimRight(csp, "; ") + "; report-uri " + p.ReportURI + "; report-to " + cspReportGroup
+		headers["Reporting-Endpoints"] = cspReportGroup + `="` + p.ReportURI + `"`
+	}
+	cspHeader := "Content-Security-Policy"
+	if p.CSPReportOnly {
+		cspHeader = "Content-Security-Policy-Report-Only"
+	}
+	useNonce := strings.Contains(csp, "{nonce}")
+
+	return func(c *gin.Context) {
+		h := c.Writer.Header()
+		for name, value := range headers {
+			if value != "" {
+				h.Set(name, value)
+			}
+		}
+
+		if csp != "" {
+			if useNonce {
+				nonce := newNonce()
+				c.Set(CSPNonceKey, nonce)
+				h.Set(cspHeader, strings.ReplaceAll(csp, "{nonce}", nonce))
+			} else {
+				h.Set(cspHeader, csp)
+			}
+		}
+
+		c.Next()
+	}
+}
+
+// newNonce - 128 bit, base64. crypto/rand hata verirse güvenli bir nonce üretilemez.
+func newNonce() string {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		panic(err)
+	}
+	return base64.StdEncoding.EncodeToString(b)
+}
@@ -0,0 +1,62 @@
+package accessrule
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/okanay/go-template/pkg/redis"
+)
+
+type Kind string
+
+const (
+	KindCIDR    Kind = "cidr"    // "203.0.113.0/24", "2001:db8::/32" veya tek IP
+	KindCountry Kind = "country" // CF-IPCountry: ISO 3166-1 alpha-2 ("TR", "RU"), "T1" (Tor)
+)
+
+type Action string
+
+const (
+	ActionAllow Action = "allow"
+	ActionDeny  Action = "deny"
+)
+
+type Rule struct {
+	ID        uuid.UUID  `json:"id"`
+	Scope     string     `json:"scope"`
+	Kind      Kind       `json:"kind"`
+	Action    Action     `json:"action"`
+	Value     string     `json:"value"`
+	Note      string     `json:"note"`
+	CreatedBy *uuid.UUID `json:"createdBy,omitempty"`
+	CreatedAt time.Time  `json:"createdAt"`
+}
+
+// RuleSet - Bir scope'un tüm kuralları. Redis'te scope başına tek kayıt olarak tutulur.
+type RuleSet struct {
+	Scope string `json:"scope"`
+	Rules []Rule `json:"rules"`
+}
+
+func (s RuleSet) GetID() string {
+	return s.Scope
+}
+
+func (s RuleSet) GetDependencies() []redis.Dependency {
+	return nil
+}
+
+// Decision - Değerlendirme sonucu. Blocked ise Reason ve Rule loglanır.
+type Decision struct {
+	Blocked bool
+	Reason  string
+	Rule    *Rule
+}
+
+type CreateRuleInput struct {
+	Scope  string `json:"scope" validate:"required,max=50,slug_format"`
+	Kind   Kind   `json:"kind" validate:"required,oneof=cidr country"`
+	Action Action `json:"action" validate:"required,oneof=allow deny"`
+	Value  string `json:"value" validate:"required,max=64"`
+	Note   string `json:"note" validate:"max=500"`
+}
@@ -0,0 +1,101 @@
+package accessrule
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/okanay/go-template/internal/auth"
+	"github.com/okanay/go-template/pkg/apierror"
+	validation "github.com/okanay/go-template/pkg/validator"
+)
+
+type Handler struct {
+	service   *Service
+	validator *validation.Validator
+}
+
+func NewHandler(service *Service, v *validation.Validator) *Handler {
+	return &Handler{
+		service:   service,
+		validator: v,
+	}
+}
+
+// ListRules - GET /admin/access-rules?scope=admin
+func (h *Handler) ListRules(c *gin.Context) {
+	rules, err := h.service.List(c.Request.Context(), c.Query("scope"))
+	if err != nil {
+		apierror.Error(c, http.StatusInternalServerError, apierror.ErrInternal, apierror.MsgInternal)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    rules,
+	})
+}
+
+// CreateRule - POST /admin/access-rules
+func (h *Handler) CreateRule(c *gin.Context) {
+	adminID, ok := auth.UserIDFromContext(c)
+	if !ok {
+		apierror.Error(c, http.StatusUnauthorized, apierror.ErrUnauthorized, apierror.MsgUnauthorized)
+		return
+	}
+
+	var input CreateRuleInput
+	if violations := h.validator.BindAndValidate(c, &input, validation.JSON); violations != nil {
+		apierror.ValidationError(c, violations)
+		return
+	}
+
+	rule, err := h.service.Create(c.Request.Context(), adminID, input)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"success": true,
+		"data":    rule,
+	})
+}
+
+// DeleteRule - DELETE /admin/access-rules/:id
+func (h *Handler) DeleteRule(c *gin.Context) {
+	adminID, ok := auth.UserIDFromContext(c)
+	if !ok {
+		apierror.Error(c, http.StatusUnauthorized, apierror.ErrUnauthorized, apierror.MsgUnauthorized)
+		return
+	}
+
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		apierror.Error(c, http.StatusNotFound, apierror.ErrNotFound, apierror.MsgNotFound)
+		return
+	}
+
+	if err := h.service.Delete(c.Request.Context(), adminID, id); err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+	})
+}
+
+func (h *Handler) handleError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, ErrRuleNotFound):
+		apierror.Error(c, http.StatusNotFound, apierror.ErrNotFound, apierror.MsgNotFound)
+	case errors.Is(err, ErrRuleExists):
+		apierror.Error(c, http.StatusConflict, apierror.ErrConflict, apierror.MsgConflict)
+	case errors.Is(err, ErrInvalidValue):
+		apierror.Error(c, http.StatusBadRequest, apierror.ErrBadRequest, "Invalid IP address, CIDR range or country code.")
+	default:
+		apierror.Error(c, http.StatusInternalServerError, apierror.ErrInternal, apierror.MsgInternal)
+	}
+}
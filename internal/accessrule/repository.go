@@ -0,0 +1,73 @@
+package accessrule
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+var (
+	ErrRuleNotFound = errors.New("access rule not found")
+	ErrRuleExists   = errors.New("access rule already exists")
+)
+
+type Repository struct {
+	db *sql.DB
+}
+
+func NewRepository(db *sql.DB) *Repository {
+	return &Repository{db: db}
+}
+
+const ruleColumns = `id, scope, kind, action, value, note, created_by, created_at`
+
+// SelectRules - scope boşsa tüm kuralları döner.
+func (r *Repository) SelectRules(ctx context.Context, scope string) ([]Rule, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT `+ruleColumns+` FROM access_rules
+		WHERE $1 = '' OR scope = $1
+		ORDER BY scope, kind, action, value`, scope)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	rules := []Rule{}
+	for rows.Next() {
+		var rule Rule
+		if err := rows.Scan(&rule.ID, &rule.Scope, &rule.Kind, &rule.Action, &rule.Value, &rule.Note, &rule.CreatedBy, &rule.CreatedAt); err != nil {
+			return nil, err
+		}
+		rules = append(rules, rule)
+	}
+	return rules, rows.Err()
+}
+
+func (r *Repository) InsertRule(ctx context.Context, rule *Rule) error {
+	err := r.db.QueryRowContext(ctx, `
+		INSERT INTO access_rules (id, scope, kind, action, value, note, created_by)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		RETURNING created_at`,
+		rule.ID, rule.Scope, rule.Kind, rule.Action, rule.Value, rule.Note, rule.CreatedBy).
+		Scan(&rule.CreatedAt)
+
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) && pqErr.Code == "23505" {
+		return ErrRuleExists
+	}
+	return err
+}
+
+// DeleteRule - Silinen kuralın scope'unu döner (cache invalidation için).
+func (r *Repository) DeleteRule(ctx context.Context, id uuid.UUID) (string, error) {
+	var scope string
+	err := r.db.QueryRowContext(ctx, `
+		DELETE FROM access_rules WHERE id = $1 RETURNING scope`, id).Scan(&scope)
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", ErrRuleNotFound
+	}
+	return scope, err
+}
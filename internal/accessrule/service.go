@@ -0,0 +1,216 @@
+package accessrule
+
+import (
+	"context"
+	"errors"
+	"net/netip"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/okanay/go-template/pkg/logger"
+	"github.com/okanay/go-template/pkg/redis"
+)
+
+const (
+	cacheDomain = "access"
+	cacheTTL    = 10 * time.Minute
+)
+
+var ErrInvalidValue = errors.New("invalid access rule value")
+
+type Service struct {
+	repo *Repository
+}
+
+func NewService(repo *Repository) *Service {
+	return &Service{repo: repo}
+}
+
+// Check - İsteği scope'un kurallarına göre değerlendirir.
+// Kurallar okunamazsa hata döner; middleware bu durumda isteği geçirmez (fail-closed).
+func (s *Service) Check(ctx context.Context, scope, ip, country string) (Decision, error) {
+	set, err := s.cached(ctx, scope)
+	if err != nil {
+		return Decision{}, err
+	}
+
+	addr, _ := netip.ParseAddr(ip)
+	return Evaluate(set.Rules, addr.Unmap(), strings.ToUpper(country)), nil
+}
+
+// Evaluate - Kural sırası:
+//  1. IP'yi kapsayan bir deny CIDR varsa engellenir
+//  2. Ülke bir deny country kuralındaysa engellenir
+//  3. En az bir allow CIDR varsa IP bunlardan birinde olmalı
+//  4. En az bir allow country varsa ülke bunlardan biri olmalı
+//
+// Ülke bilinmiyorsa (Cloudflare arkasında değil veya "XX") country allow-list'i geçilemez.
+func Evaluate(rules []Rule, addr netip.Addr, country string) Decision {
+	var hasCIDRAllow, cidrAllowed, hasCountryAllow, countryAllowed bool
+
+	for i := range rules {
+		rule := &rules[i]
+
+		var matched bool
+		switch rule.Kind {
+		case KindCIDR:
+			matched = matchCIDR(rule.Value, addr)
+		case KindCountry:
+			matched = country != "" && rule.Value == country
+		default:
+			continue
+		}
+
+		if rule.Action == ActionDeny {
+			if matched {
+				return Decision{Blocked: true, Reason: string(rule.Kind) + "_denied", Rule: rule}
+			}
+			continue
+		}
+
+		if rule.Kind == KindCIDR {
+			hasCIDRAllow = true
+			cidrAllowed = cidrAllowed || matched
+		} else {
+			hasCountryAllow = true
+			countryAllowed = countryAllowed || matched
+		}
+	}
+
+	if hasCIDRAllow && !cidrAllowed {
+		return Decision{Blocked: true, Reason: "cidr_not_allowed"}
+	}
+	if hasCountryAllow && !countryAllowed {
+		return Decision{Blocked: true, Reason: "country_not_allowed"}
+	}
+	return Decision{}
+}
+
+func matchCIDR(value string, addr netip.Addr) bool {
+	if !addr.IsValid() {
+		return false
+	}
+	prefix, err := netip.ParsePrefix(value)
+	return err == nil && prefix.Contains(addr)
+}
+
+// cached - Scope'un kural seti. Kuralı olmayan scope'lar da (boş set) cache'lenir.
+func (s *Service) cached(ctx context.Context, scope string) (*RuleSet, error) {
+	set, err := redis.GetItem(ctx, cacheDomain, scope, cacheTTL, redis.GetOptions{},
+		func() (RuleSet, error) {
+			rules, err := s.repo.SelectRules(ctx, scope)
+			if err != nil {
+				return RuleSet{}, err
+			}
+			return RuleSet{Scope: scope, Rules: rules}, nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &set, nil
+}
+
+// ─── Admin ───────────────────────────────────────────────────────
+
+// List - scope boşsa tüm scope'ların kurallarını döner.
+func (s *Service) List(ctx context.Context, scope string) ([]Rule, error) {
+	return s.repo.SelectRules(ctx, scope)
+}
+
+func (s *Service) Create(ctx context.Context, adminID uuid.UUID, input CreateRuleInput) (*Rule, error) {
+	value, err := normalizeValue(input.Kind, input.Value)
+	if err != nil {
+		return nil, err
+	}
+
+	rule := &Rule{
+		ID:        uuid.New(),
+		Scope:     input.Scope,
+		Kind:      input.Kind,
+		Action:    input.Action,
+		Value:     value,
+		Note:      input.Note,
+		CreatedBy: &adminID,
+	}
+	if err := s.repo.InsertRule(ctx, rule); err != nil {
+		return nil, err
+	}
+
+	s.invalidate(ctx, rule.Scope)
+	logger.Component("access").InfoContext(ctx, "access rule created",
+		"rule_id", rule.ID.String(),
+		"scope", rule.Scope,
+		"kind", string(rule.Kind),
+		"action", string(rule.Action),
+		"value", rule.Value,
+		"admin_id", adminID.String(),
+	)
+	return rule, nil
+}
+
+func (s *Service) Delete(ctx context.Context, adminID uuid.UUID, id uuid.UUID) error {
+	scope, err := s.repo.DeleteRule(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	s.invalidate(ctx, scope)
+	logger.Component("access").InfoContext(ctx, "access rule deleted",
+		"rule_id", id.String(),
+		"scope", scope,
+		"admin_id", adminID.String(),
+	)
+	return nil
+}
+
+func (s *Service) invalidate(ctx context.Context, scope string) {
+	if err := redis.InvalidateEntity(ctx, cacheDomain, scope); err != nil {
+		logger.Component("access").ErrorContext(ctx, "access rule cache invalidation failed", "scope", scope, "error", err)
+	}
+}
+
+// normalizeValue - "10.0.0.5" -> "10.0.0.5/32", "10.0.0.9/24" -> "10.0.0.0/24", "tr" -> "TR".
+// Aynı kural farklı yazımlarla iki kez eklenemez (UNIQUE constraint normalize değere uygulanır).
+func normalizeValue(kind Kind, value string) (string, error) {
+	value = strings.TrimSpace(value)
+
+	switch kind {
+	case KindCIDR:
+		if !strings.Contains(value, "/") {
+			addr, err := netip.ParseAddr(value)
+			if err != nil {
+				return "", ErrInvalidValue
+			}
+			addr = addr.Unmap()
+			return netip.PrefixFrom(addr, addr.BitLen()).String(), nil
+		}
+		prefix, err := netip.ParsePrefix(value)
+		if err != nil {
+			return "", ErrInvalidValue
+		}
+		if prefix.Addr().Is4In6() {
+			prefix = netip.PrefixFrom(prefix.Addr().Unmap(), prefix.Bits()-96)
+		}
+		return prefix.Masked().String(), nil
+
+	case KindCountry:
+		value = strings.ToUpper(value)
+		if len(value) != 2 || !isAlnum(value) {
+			return "", ErrInvalidValue
+		}
+		return value, nil
+	}
+
+	return "", ErrInvalidValue
+}
+
+func isAlnum(s string) bool {
+	for _, r := range s {
+		if (r < 'A' || r > 'Z') && (r < '0' || r > '9') {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,42 @@
+package account
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// DataContributor, kullanıcı verisi tutan her internal modülün uyguladığı hook.
+// Modüller Service.Register ile kaydolur; export ve kalıcı silme bu arayüz üzerinden yürür.
+type DataContributor interface {
+	// Name - Export arşivinde modülün verisinin tutulduğu anahtar (örn: "files")
+	Name() string
+	// ExportUserData - JSON'a çevrilebilir herhangi bir değer dönebilir.
+	ExportUserData(ctx context.Context, userID uuid.UUID) (any, error)
+	// PurgeUserData - Grace period dolduğunda çağrılır, idempotent olmalı.
+	PurgeUserData(ctx context.Context, userID uuid.UUID) error
+}
+
+type DeletionStatus string
+
+const (
+	DeletionPending DeletionStatus = "pending"
+	DeletionPurging DeletionStatus = "purging"
+	DeletionPurged  DeletionStatus = "purged"
+)
+
+type DeletionRequest struct {
+	UserID       uuid.UUID      `json:"userId"`
+	Status       DeletionStatus `json:"status"`
+	RequestedAt  time.Time      `json:"requestedAt"`
+	ScheduledFor time.Time      `json:"scheduledFor"`
+	PurgedAt     *time.Time     `json:"purgedAt,omitempty"`
+}
+
+// Export, bir kullanıcının tüm modüllerden toplanan verisi.
+type Export struct {
+	UserID      uuid.UUID      `json:"userId"`
+	GeneratedAt time.Time      `json:"generatedAt"`
+	Data        map[string]any `json:"data"`
+}
@@ -0,0 +1,101 @@
+package account
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/okanay/go-template/internal/auth"
+	"github.com/okanay/go-template/pkg/apierror"
+)
+
+type Handler struct {
+	service *Service
+}
+
+func NewHandler(service *Service) *Handler {
+	return &Handler{service: service}
+}
+
+// ExportData - GET /account/export?format=json|zip
+func (h *Handler) ExportData(c *gin.Context) {
+	userID, ok := auth.UserIDFromContext(c)
+	if !ok {
+		apierror.Error(c, http.StatusUnauthorized, apierror.ErrUnauthorized, apierror.MsgUnauthorized)
+		return
+	}
+
+	export, err := h.service.Export(c.Request.Context(), userID)
+	if err != nil {
+		apierror.Error(c, http.StatusInternalServerError, apierror.ErrInternal, apierror.MsgInternal)
+		return
+	}
+
+	if c.DefaultQuery("format", "json") == "zip" {
+		filename := fmt.Sprintf("account-export-%s.zip", export.GeneratedAt.Format("20060102-150405"))
+		c.Header("Content-Type", "application/zip")
+		c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, filename))
+		c.Status(http.StatusOK)
+
+		if err := h.service.WriteZip(c.Writer, export); err != nil {
+			// Header'lar gönderildiği için artık JSON hata dönemeyiz
+			_ = c.Error(err)
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    export,
+	})
+}
+
+// RequestDeletion - DELETE /account
+func (h *Handler) RequestDeletion(c *gin.Context) {
+	userID, ok := auth.UserIDFromContext(c)
+	if !ok {
+		apierror.Error(c, http.StatusUnauthorized, apierror.ErrUnauthorized, apierror.MsgUnauthorized)
+		return
+	}
+
+	req, err := h.service.RequestDeletion(c.Request.Context(), userID)
+	if err != nil {
+		if errors.Is(err, ErrAlreadyPurging) {
+			apierror.Error(c, http.StatusConflict, apierror.ErrConflict, "Account deletion is already in progress.")
+			return
+		}
+		apierror.Error(c, http.StatusInternalServerError, apierror.ErrInternal, apierror.MsgInternal)
+		return
+	}
+
+	// Oturumlar iptal edildi, cookie'leri de temizle
+	h.service.authService.ClearCookies(c)
+
+	c.JSON(http.StatusAccepted, gin.H{
+		"success": true,
+		"data":    req,
+	})
+}
+
+// CancelDeletion - POST /account/deletion/cancel
+func (h *Handler) CancelDeletion(c *gin.Context) {
+	userID, ok := auth.UserIDFromContext(c)
+	if !ok {
+		apierror.Error(c, http.StatusUnauthorized, apierror.ErrUnauthorized, apierror.MsgUnauthorized)
+		return
+	}
+
+	if err := h.service.CancelDeletion(c.Request.Context(), userID); err != nil {
+		if errors.Is(err, ErrDeletionNotFound) {
+			apierror.Error(c, http.StatusNotFound, apierror.ErrNotFound, "No pending deletion request.")
+			return
+		}
+		apierror.Error(c, http.StatusInternalServerError, apierror.ErrInternal, apierror.MsgInternal)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+	})
+}
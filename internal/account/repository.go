@@ -0,0 +1,101 @@
+package account
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var ErrDeletionNotFound = errors.New("deletion request not found")
+
+type Repository struct {
+	db *sql.DB
+}
+
+func NewRepository(db *sql.DB) *Repository {
+	return &Repository{db: db}
+}
+
+// UpsertDeletionRequest - Talep zaten varsa süreyi yeniden başlatır.
+func (r *Repository) UpsertDeletionRequest(ctx context.Context, userID uuid.UUID, scheduledFor time.Time) (*DeletionRequest, error) {
+	var d DeletionRequest
+	err := r.db.QueryRowContext(ctx, `
+		INSERT INTO account_deletions (user_id, status, requested_at, scheduled_for)
+		VALUES ($1, 'pending', NOW(), $2)
+		ON CONFLICT (user_id) DO UPDATE
+			SET status = 'pending', requested_at = NOW(), scheduled_for = EXCLUDED.scheduled_for, purged_at = NULL
+		RETURNING user_id, status, requested_at, scheduled_for, purged_at`, userID, scheduledFor).
+		Scan(&d.UserID, &d.Status, &d.RequestedAt, &d.ScheduledFor, &d.PurgedAt)
+	if err != nil {
+		return nil, err
+	}
+	return &d, nil
+}
+
+func (r *Repository) SelectDeletionRequest(ctx context.Context, userID uuid.UUID) (*DeletionRequest, error) {
+	var d DeletionRequest
+	err := r.db.QueryRowContext(ctx, `
+		SELECT user_id, status, requested_at, scheduled_for, purged_at
+		FROM account_deletions WHERE user_id = $1`, userID).
+		Scan(&d.UserID, &d.Status, &d.RequestedAt, &d.ScheduledFor, &d.PurgedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrDeletionNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &d, nil
+}
+
+// DeletePendingRequest - Sadece henüz işlenmeye başlanmamış talepleri iptal eder.
+func (r *Repository) DeletePendingRequest(ctx context.Context, userID uuid.UUID) error {
+	res, err := r.db.ExecContext(ctx, `
+		DELETE FROM account_deletions WHERE user_id = $1 AND status = 'pending'`, userID)
+	if err != nil {
+		return err
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return ErrDeletionNotFound
+	}
+	return nil
+}
+
+// ClaimDueDeletions - Süresi dolan talepleri "purging" olarak işaretler ve döner.
+// SKIP LOCKED sayesinde birden fazla instance aynı talebi almaz.
+func (r *Repository) ClaimDueDeletions(ctx context.Context, limit int) ([]uuid.UUID, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		UPDATE account_deletions SET status = 'purging'
+		WHERE user_id IN (
+			SELECT user_id FROM account_deletions
+			WHERE status = 'pending' AND scheduled_for <= NOW()
+			ORDER BY scheduled_for
+			LIMIT $1
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING user_id`, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var ids []uuid.UUID
+	for rows.Next() {
+		var id uuid.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, rows.Err()
+}
+
+func (r *Repository) UpdateDeletionStatus(ctx context.Context, userID uuid.UUID, status DeletionStatus) error {
+	_, err := r.db.ExecContext(ctx, `
+		UPDATE account_deletions
+		SET status = $2, purged_at = CASE WHEN $2 = 'purged' THEN NOW() ELSE purged_at END
+		WHERE user_id = $1`, userID, status)
+	return err
+}
@@ -0,0 +1,179 @@
+package account
+
+import (
+	"archive/zip"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/goccy/go-json"
+	"github.com/google/uuid"
+	"github.com/okanay/go-template/internal/auth"
+	"github.com/okanay/go-template/pkg/logger"
+	"github.com/okanay/go-template/pkg/utils"
+)
+
+const (
+	// DeletionGracePeriod - Talep ile kalıcı silme arasındaki süre. Bu sürede talep iptal edilebilir.
+	DeletionGracePeriod = 30 * 24 * time.Hour
+	// purgeBatchSize - Cron'un tek çalışmada işleyeceği maksimum hesap sayısı
+	purgeBatchSize = 20
+)
+
+var ErrAlreadyPurging = errors.New("account deletion is already in progress")
+
+type Service struct {
+	repo         *Repository
+	authService  *auth.Service
+	contributors []DataContributor
+}
+
+func NewService(repo *Repository, authService *auth.Service) *Service {
+	return &Service{
+		repo:        repo,
+		authService: authService,
+	}
+}
+
+// Register - Modüller kendi DataContributor'larını burada kaydeder (main.go içinde).
+func (s *Service) Register(contributors ...DataContributor) {
+	s.contributors = append(s.contributors, contributors...)
+}
+
+// Export - Profil ve kayıtlı tüm modüllerin verisini tek bir yapıda toplar.
+func (s *Service) Export(ctx context.Context, userID uuid.UUID) (*Export, error) {
+	user, err := s.authService.GetUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	sessions, err := s.authService.ListSessions(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	export := &Export{
+		UserID:      userID,
+		GeneratedAt: utils.Now(),
+		Data: map[string]any{
+			"profile":  user,
+			"sessions": sessions,
+		},
+	}
+
+	for _, c := range s.contributors {
+		data, err := c.ExportUserData(ctx, userID)
+		if err != nil {
+			return nil, fmt.Errorf("%s export failed: %w", c.Name(), err)
+		}
+		export.Data[c.Name()] = data
+	}
+
+	req, err := s.repo.SelectDeletionRequest(ctx, userID)
+	switch {
+	case err == nil:
+		export.Data["deletion"] = req
+	case !errors.Is(err, ErrDeletionNotFound):
+		return nil, fmt.Errorf("deletion export failed: %w", err)
+	}
+
+	return export, nil
+}
+
+// WriteZip - Export'u her modül için ayrı bir JSON dosyası içeren ZIP olarak yazar.
+func (s *Service) WriteZip(w io.Writer, export *Export) error {
+	zw := zip.NewWriter(w)
+
+	manifest := map[string]any{
+		"userId":      export.UserID,
+		"generatedAt": export.GeneratedAt,
+	}
+	if err := writeZipJSON(zw, "manifest.json", manifest); err != nil {
+		return err
+	}
+
+	for name, data := range export.Data {
+		if err := writeZipJSON(zw, name+".json", data); err != nil {
+			return err
+		}
+	}
+
+	return zw.Close()
+}
+
+func writeZipJSON(zw *zip.Writer, name string, data any) error {
+	f, err := zw.Create(name)
+	if err != nil {
+		return err
+	}
+	enc := json.NewEncoder(f)
+	enc.SetIndent("", "  ")
+	return enc.Encode(data)
+}
+
+// RequestDeletion - Hesabı silinmek üzere işaretler ve tüm oturumları kapatır.
+// Kalıcı silme DeletionGracePeriod sonunda PurgeDueAccounts cron'u tarafından yapılır.
+func (s *Service) RequestDeletion(ctx context.Context, userID uuid.UUID) (*DeletionRequest, error) {
+	if existing, err := s.repo.SelectDeletionRequest(ctx, userID); err == nil && existing.Status == DeletionPurging {
+		return nil, ErrAlreadyPurging
+	}
+
+	req, err := s.repo.UpsertDeletionRequest(ctx, userID, utils.Now().Add(DeletionGracePeriod))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.authService.MarkPendingDeletion(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	if err := s.authService.RevokeAllSessions(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// CancelDeletion - Grace period içinde tekrar giriş yapan kullanıcı talebini geri alabilir.
+func (s *Service) CancelDeletion(ctx context.Context, userID uuid.UUID) error {
+	if err := s.repo.DeletePendingRequest(ctx, userID); err != nil {
+		return err
+	}
+	return s.authService.MarkActive(ctx, userID)
+}
+
+// PurgeDueAccounts - Cron job: süresi dolan hesapların verisini tüm modüllerden siler.
+func (s *Service) PurgeDueAccounts(ctx context.Context) error {
+	ids, err := s.repo.ClaimDueDeletions(ctx, purgeBatchSize)
+	if err != nil {
+		return err
+	}
+
+	for _, userID := range ids {
+		if err := s.purge(ctx, userID); err != nil {
+			// Tekrar denenebilmesi için talebi pending'e geri al
+			logger.Component("account").ErrorContext(ctx, "account purge failed", "user_id", userID, "error", err)
+			_ = s.repo.UpdateDeletionStatus(ctx, userID, DeletionPending)
+			continue
+		}
+		logger.Component("account").InfoContext(ctx, "account purged", "user_id", userID)
+	}
+
+	return nil
+}
+
+func (s *Service) purge(ctx context.Context, userID uuid.UUID) error {
+	for _, c := range s.contributors {
+		if err := c.PurgeUserData(ctx, userID); err != nil {
+			return fmt.Errorf("%s purge failed: %w", c.Name(), err)
+		}
+	}
+
+	if err := s.authService.DeleteUser(ctx, userID); err != nil {
+		return err
+	}
+
+	return s.repo.UpdateDeletionStatus(ctx, userID, DeletionPurged)
+}
@@ -1,6 +1,8 @@
 package auth
 
 import (
+	"time"
+
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/google/uuid"
 )
@@ -8,5 +10,50 @@ import (
 type Claims struct {
 	UserID uuid.UUID `json:"user_id"`
 	Role   string    `json:"role"`
+	// TenantID - Boşsa kullanıcı bir tenant'a bağlı değil.
+	TenantID string `json:"tenant_id,omitempty"`
+	// AuthTime - Kullanıcının en son şifre/MFA ile doğrulandığı an (OIDC auth_time).
+	// Token yenilemelerinde korunur, sadece login ve re-authenticate ile güncellenir.
+	AuthTime *jwt.NumericDate `json:"auth_time,omitempty"`
+	// IssuedAtMs - iat saniye hassasiyetinde; revoke ile aynı saniyede üretilen token'ları ayırt etmek için.
+	IssuedAtMs int64 `json:"iat_ms,omitempty"`
 	jwt.RegisteredClaims
 }
+
+type UserStatus string
+
+const (
+	UserStatusActive          UserStatus = "active"
+	UserStatusPendingDeletion UserStatus = "pending_deletion"
+)
+
+type User struct {
+	ID           uuid.UUID  `json:"id"`
+	Email        string     `json:"email"`
+	PasswordHash string     `json:"-"`
+	Role         string     `json:"role"`
+	TenantID     string     `json:"tenantId"`
+	Status       UserStatus `json:"status"`
+	Locale       string     `json:"locale"`
+	CreatedAt    time.Time  `json:"createdAt"`
+	UpdatedAt    time.Time  `json:"updatedAt"`
+}
+
+type Session struct {
+	ID        uuid.UUID  `json:"id"`
+	UserID    uuid.UUID  `json:"userId"`
+	IPAddress string     `json:"ipAddress"`
+	UserAgent string     `json:"userAgent"`
+	ExpiresAt time.Time  `json:"expiresAt"`
+	RevokedAt *time.Time `json:"revokedAt,omitempty"`
+	CreatedAt time.Time  `json:"createdAt"`
+}
+
+type ReauthenticateInput struct {
+	Password string `json:"password" validate:"required_without=MFACode"`
+	MFACode  string `json:"mfaCode" validate:"required_without=Password,omitempty,numeric,len=6"`
+}
+
+type UpdateLocaleInput struct {
+	Locale string `json:"locale" validate:"required,oneof=tr en"`
+}
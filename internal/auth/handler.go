@@ -1,8 +1,94 @@
 package auth
 
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/okanay/go-template/pkg/apierror"
+	validation "github.com/okanay/go-template/pkg/validator"
+)
+
 type Handler struct {
+	service   *Service
+	validator *validation.Validator
+}
+
+func NewHandler(service *Service, v *validation.Validator) *Handler {
+	return &Handler{
+		service:   service,
+		validator: v,
+	}
 }
 
-func NewHandler() *Handler {
-	return &Handler{}
+// Reauthenticate - POST /auth/reauthenticate
+// Hassas işlemlerden önce (email değişikliği, hesap silme, API key oluşturma) çağrılır.
+func (h *Handler) Reauthenticate(c *gin.Context) {
+	userID, ok := UserIDFromContext(c)
+	if !ok {
+		apierror.Error(c, http.StatusUnauthorized, apierror.ErrUnauthorized, apierror.MsgUnauthorized)
+		return
+	}
+
+	var input ReauthenticateInput
+	if violations := h.validator.BindAndValidate(c, &input, validation.JSON); violations != nil {
+		apierror.ValidationError(c, violations)
+		return
+	}
+
+	var err error
+	if h.service.SessionBackend() == SessionBackendRedis {
+		sessionID, _ := c.Cookie(SessionCookieName)
+		err = h.service.ReauthenticateSession(c.Request.Context(), userID, sessionID, input)
+	} else {
+		var accessToken string
+		accessToken, err = h.service.Reauthenticate(c.Request.Context(), userID, input)
+		if err == nil {
+			h.service.SetAccessTokenCookie(c, accessToken)
+		}
+	}
+
+	if err != nil {
+		switch {
+		case errors.Is(err, ErrInvalidCredentials):
+			apierror.Error(c, http.StatusUnauthorized, apierror.ErrInvalidCredentials, apierror.MsgInvalidCredentials)
+		case errors.Is(err, ErrSessionNotFound):
+			apierror.Error(c, http.StatusUnauthorized, apierror.ErrUnauthorized, apierror.MsgUnauthorized)
+		case errors.Is(err, ErrMFANotConfigured):
+			apierror.Error(c, http.StatusBadRequest, apierror.ErrBadRequest, "MFA is not enabled for this account.")
+		default:
+			apierror.Error(c, http.StatusInternalServerError, apierror.ErrInternal, apierror.MsgInternal)
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+	})
+}
+
+// UpdateLocale - PUT /auth/locale
+// Kayıtlı dil tercihi, X-Language header'ı gönderilmeyen isteklerde Accept-Language'dan önce gelir.
+func (h *Handler) UpdateLocale(c *gin.Context) {
+	userID, ok := UserIDFromContext(c)
+	if !ok {
+		apierror.Error(c, http.StatusUnauthorized, apierror.ErrUnauthorized, apierror.MsgUnauthorized)
+		return
+	}
+
+	var input UpdateLocaleInput
+	if violations := h.validator.BindAndValidate(c, &input, validation.JSON); violations != nil {
+		apierror.ValidationError(c, violations)
+		return
+	}
+
+	if err := h.service.UpdateLocale(c.Request.Context(), userID, input.Locale); err != nil {
+		apierror.Error(c, http.StatusInternalServerError, apierror.ErrInternal, apierror.MsgInternal)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    gin.H{"locale": input.Locale},
+	})
 }
@@ -8,6 +8,7 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/google/uuid"
+	"github.com/okanay/go-template/pkg/redis"
 	"github.com/okanay/go-template/pkg/utils"
 )
 
@@ -16,33 +17,41 @@ const (
 	RefreshTokenDuration   = 7 * 24 * time.Hour
 	AccessTokenCookieName  = "access_token"
 	RefreshTokenCookieName = "refresh_token"
+
+	// TenantIDContextKey - AuthMiddleware kullanıcının tenant'ını (users.tenant_id) bu key'e yazar.
+	TenantIDContextKey = "tenantID"
+
+	// localeCacheTTL - Dil tercihi nadiren değişir; UpdateLocale cache'i direkt siler.
+	localeCacheTTL = time.Hour
 )
 
-func GenerateAccessToken(userID uuid.UUID, role string) (string, error) {
-	secret := utils.GetEnv("JWT_ACCESS_SECRET", "")
-	if secret == "" {
-		return "", errors.New("JWT_ACCESS_SECRET environment variable is not set")
+func (s *Service) GenerateAccessToken(user *User, authTime time.Time) (string, error) {
+	if s.cfg.JWTAccessSecret == "" {
+		return "", errors.New("JWT_ACCESS_SECRET is not set")
 	}
 
 	now := utils.Now()
 	claims := Claims{
-		UserID: userID,
-		Role:   role,
+		UserID:     user.ID,
+		Role:       user.Role,
+		TenantID:   user.TenantID,
+		AuthTime:   jwt.NewNumericDate(authTime),
+		IssuedAtMs: now.UnixMilli(),
 		RegisteredClaims: jwt.RegisteredClaims{
 			ExpiresAt: jwt.NewNumericDate(now.Add(AccessTokenDuration)),
 			IssuedAt:  jwt.NewNumericDate(now),
 			NotBefore: jwt.NewNumericDate(now),
-			Issuer:    utils.GetEnv("TOKEN_ISSUER", "MY_JWT_ISSUER_NAME"),
-			Subject:   userID.String(),
+			Issuer:    s.cfg.TokenIssuer,
+			Subject:   user.ID.String(),
 		},
 	}
 
 	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	return token.SignedString([]byte(secret))
+	return token.SignedString([]byte(s.cfg.JWTAccessSecret))
 }
 
-func GenerateTokens(userID uuid.UUID, role string) (accessToken string, refreshToken string, err error) {
-	accessToken, err = GenerateAccessToken(userID, role)
+func (s *Service) GenerateTokens(user *User, authTime time.Time) (accessToken string, refreshToken string, err error) {
+	accessToken, err = s.GenerateAccessToken(user, authTime)
 	if err != nil {
 		return "", "", err
 	}
@@ -56,14 +65,12 @@ func GenerateTokens(userID uuid.UUID, role string) (accessToken string, refreshT
 	return accessToken, refreshToken, nil
 }
 
-func ValidateToken(tokenString string) (*Claims, error) {
-	secret := utils.GetEnv("JWT_ACCESS_SECRET", "")
-
+func (s *Service) ValidateToken(tokenString string) (*Claims, error) {
 	token, err := jwt.ParseWithClaims(tokenString, &Claims{}, func(token *jwt.Token) (any, error) {
 		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
 			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
 		}
-		return []byte(secret), nil
+		return []byte(s.cfg.JWTAccessSecret), nil
 	})
 
 	if err != nil {
@@ -91,34 +98,86 @@ func ShouldRefreshToken(claims *Claims) bool {
 	return remainingDuration < (totalDuration / 4)
 }
 
-func SetCookies(c *gin.Context, accessToken, refreshToken string) {
-	domain := utils.GetEnv("COOKIE_DOMAIN", "localhost")
-	secure := utils.GetEnvBool("COOKIE_SECURE", false)
+func (s *Service) SetCookies(c *gin.Context, accessToken, refreshToken string) {
+	s.SetAccessTokenCookie(c, accessToken)
 
 	c.SetCookie(
-		AccessTokenCookieName,
-		accessToken,
-		int(AccessTokenDuration.Seconds()),
+		RefreshTokenCookieName,
+		refreshToken,
+		int(RefreshTokenDuration.Seconds()),
 		"/",
-		domain,
-		secure,
+		s.cookie.Domain,
+		s.cookie.Secure,
 		true,
 	)
+}
 
+// SetAccessTokenCookie - Refresh token'a dokunmadan sadece access token'ı yeniler (re-authenticate).
+func (s *Service) SetAccessTokenCookie(c *gin.Context, accessToken string) {
 	c.SetCookie(
-		RefreshTokenCookieName,
-		refreshToken,
-		int(RefreshTokenDuration.Seconds()),
+		AccessTokenCookieName,
+		accessToken,
+		int(AccessTokenDuration.Seconds()),
 		"/",
-		domain,
-		secure,
+		s.cookie.Domain,
+		s.cookie.Secure,
 		true,
 	)
 }
 
-func ClearCookies(c *gin.Context) {
-	domain := utils.GetEnv("COOKIE_DOMAIN", "localhost")
+// AuthTimeFromClaims - auth_time claim'i yoksa (eski token'lar) iat kullanılır.
+func AuthTimeFromClaims(claims *Claims) time.Time {
+	if claims.AuthTime != nil {
+		return claims.AuthTime.Time
+	}
+	if claims.IssuedAt != nil {
+		return claims.IssuedAt.Time
+	}
+	return time.Time{}
+}
+
+// ReauthWindow - Hassas işlemler için kabul edilen maksimum doğrulama yaşı.
+func (s *Service) ReauthWindow() time.Duration {
+	return time.Duration(s.cfg.ReauthWindowMinutes) * time.Minute
+}
+
+func (s *Service) ClearCookies(c *gin.Context) {
+	c.SetCookie(AccessTokenCookieName, "", -1, "/", s.cookie.Domain, false, true)
+	c.SetCookie(RefreshTokenCookieName, "", -1, "/", s.cookie.Domain, false, true)
+	c.SetCookie(SessionCookieName, "", -1, "/", s.cookie.Domain, false, true)
+}
+
+// AuthTimeFromContext - AuthMiddleware'in context'e yazdığı son doğrulama zamanını okur.
+func AuthTimeFromContext(c *gin.Context) (time.Time, bool) {
+	val, exists := c.Get("authTime")
+	if !exists {
+		return time.Time{}, false
+	}
+	authTime, ok := val.(time.Time)
+	return authTime, ok && !authTime.IsZero()
+}
+
+// UserIDFromContext - AuthMiddleware'in context'e yazdığı kullanıcı ID'sini okur.
+func UserIDFromContext(c *gin.Context) (uuid.UUID, bool) {
+	val, exists := c.Get("userID")
+	if !exists {
+		return uuid.Nil, false
+	}
+	userID, ok := val.(uuid.UUID)
+	return userID, ok && userID != uuid.Nil
+}
+
+// TenantIDFromContext - AuthMiddleware'in context'e yazdığı tenant ID'sini okur. Tenant yoksa "".
+func TenantIDFromContext(c *gin.Context) string {
+	return c.GetString(TenantIDContextKey)
+}
+
+// localeKey -> app:auth:locale:<userID>
+func localeKey(userID uuid.UUID) string {
+	return redis.BuildKey("auth", "locale", userID.String())
+}
 
-	c.SetCookie(AccessTokenCookieName, "", -1, "/", domain, false, true)
-	c.SetCookie(RefreshTokenCookieName, "", -1, "/", domain, false, true)
+// revokedKey -> app:auth:revoked:<userID>
+func revokedKey(userID uuid.UUID) string {
+	return redis.BuildKey("auth", "revoked", userID.String())
 }
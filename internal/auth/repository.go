@@ -1 +1,88 @@
 package auth
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/google/uuid"
+)
+
+var ErrUserNotFound = errors.New("user not found")
+
+type Repository struct {
+	db *sql.DB
+}
+
+func NewRepository(db *sql.DB) *Repository {
+	return &Repository{db: db}
+}
+
+func (r *Repository) SelectUserByID(ctx context.Context, id uuid.UUID) (*User, error) {
+	var u User
+	err := r.db.QueryRowContext(ctx, `
+		SELECT id, email, password_hash, role, tenant_id, status, locale, created_at, updated_at
+		FROM users WHERE id = $1`, id).
+		Scan(&u.ID, &u.Email, &u.PasswordHash, &u.Role, &u.TenantID, &u.Status, &u.Locale, &u.CreatedAt, &u.UpdatedAt)
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrUserNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &u, nil
+}
+
+func (r *Repository) UpdateUserStatus(ctx context.Context, id uuid.UUID, status UserStatus) error {
+	_, err := r.db.ExecContext(ctx, `
+		UPDATE users SET status = $2, updated_at = NOW() WHERE id = $1`, id, status)
+	return err
+}
+
+func (r *Repository) UpdateUserLocale(ctx context.Context, id uuid.UUID, locale string) error {
+	_, err := r.db.ExecContext(ctx, `
+		UPDATE users SET locale = $2, updated_at = NOW() WHERE id = $1`, id, locale)
+	return err
+}
+
+// DeleteUser - Kullanıcıyı kalıcı olarak siler. sessions ve files CASCADE ile silinir.
+func (r *Repository) DeleteUser(ctx context.Context, id uuid.UUID) error {
+	_, err := r.db.ExecContext(ctx, `DELETE FROM users WHERE id = $1`, id)
+	return err
+}
+
+func (r *Repository) SelectSessionsByUser(ctx context.Context, userID uuid.UUID) ([]Session, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT id, user_id, ip_address, user_agent, expires_at, revoked_at, created_at
+		FROM sessions WHERE user_id = $1 ORDER BY created_at DESC`, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var sessions []Session
+	for rows.Next() {
+		var s Session
+		if err := rows.Scan(&s.ID, &s.UserID, &s.IPAddress, &s.UserAgent, &s.ExpiresAt, &s.RevokedAt, &s.CreatedAt); err != nil {
+			return nil, err
+		}
+		sessions = append(sessions, s)
+	}
+	return sessions, rows.Err()
+}
+
+func (r *Repository) InsertSession(ctx context.Context, s *Session) error {
+	_, err := r.db.ExecContext(ctx, `
+		INSERT INTO sessions (id, user_id, ip_address, user_agent, expires_at)
+		VALUES ($1, $2, $3, $4, $5)`, s.ID, s.UserID, s.IPAddress, s.UserAgent, s.ExpiresAt)
+	return err
+}
+
+// RevokeUserSessions - Kullanıcının aktif tüm refresh token oturumlarını iptal eder.
+func (r *Repository) RevokeUserSessions(ctx context.Context, userID uuid.UUID) error {
+	_, err := r.db.ExecContext(ctx, `
+		UPDATE sessions SET revoked_at = NOW()
+		WHERE user_id = $1 AND revoked_at IS NULL`, userID)
+	return err
+}
@@ -1,8 +1,185 @@
 package auth
 
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"github.com/google/uuid"
+	"github.com/okanay/go-template/configs"
+	"github.com/okanay/go-template/pkg/redis"
+	"github.com/okanay/go-template/pkg/utils"
+)
+
+var (
+	ErrInvalidCredentials = errors.New("invalid credentials")
+	ErrMFANotConfigured   = errors.New("mfa is not configured")
+)
+
+// MFAVerifier - TOTP/WebAuthn gibi ikinci faktör sağlayıcıları için arayüz.
+// Kayıtlı bir verifier yoksa re-authenticate sadece şifre ile yapılabilir.
+type MFAVerifier interface {
+	VerifyCode(ctx context.Context, userID uuid.UUID, code string) (bool, error)
+}
+
 type Service struct {
+	repo        *Repository
+	cfg         configs.AuthConfig
+	cookie      configs.CookieConfig
+	mfaVerifier MFAVerifier
+}
+
+// NewService - Auth ve cookie ayarları açılışta okunan Config'ten gelir; reload ile değişmez.
+func NewService(repo *Repository, cfg configs.AuthConfig, cookie configs.CookieConfig) *Service {
+	return &Service{repo: repo, cfg: cfg, cookie: cookie}
+}
+
+// SetMFAVerifier - MFA modülü eklendiğinde main.go içinde kaydedilir.
+func (s *Service) SetMFAVerifier(v MFAVerifier) {
+	s.mfaVerifier = v
+}
+
+// Reauthenticate - Kullanıcıyı şifre veya MFA kodu ile tekrar doğrular ve
+// auth_time'ı güncellenmiş yeni bir access token üretir.
+func (s *Service) Reauthenticate(ctx context.Context, userID uuid.UUID, input ReauthenticateInput) (string, error) {
+	user, err := s.verifyCredentials(ctx, userID, input)
+	if err != nil {
+		return "", err
+	}
+
+	return s.GenerateAccessToken(user, utils.Now())
+}
+
+// ReauthenticateSession - Redis session backend'inde token yerine session'ın auth_time'ı güncellenir.
+func (s *Service) ReauthenticateSession(ctx context.Context, userID uuid.UUID, sessionID string, input ReauthenticateInput) error {
+	if _, err := s.verifyCredentials(ctx, userID, input); err != nil {
+		return err
+	}
+
+	return s.RefreshOpaqueAuthTime(ctx, sessionID)
+}
+
+func (s *Service) verifyCredentials(ctx context.Context, userID uuid.UUID, input ReauthenticateInput) (*User, error) {
+	user, err := s.repo.SelectUserByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case input.Password != "":
+		if !utils.CheckPassword(input.Password, user.PasswordHash) {
+			return nil, ErrInvalidCredentials
+		}
+	case input.MFACode != "":
+		if s.mfaVerifier == nil {
+			return nil, ErrMFANotConfigured
+		}
+		ok, err := s.mfaVerifier.VerifyCode(ctx, userID, input.MFACode)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return nil, ErrInvalidCredentials
+		}
+	default:
+		return nil, ErrInvalidCredentials
+	}
+
+	return user, nil
+}
+
+func (s *Service) GetUser(ctx context.Context, userID uuid.UUID) (*User, error) {
+	return s.repo.SelectUserByID(ctx, userID)
+}
+
+func (s *Service) ListSessions(ctx context.Context, userID uuid.UUID) ([]Session, error) {
+	return s.repo.SelectSessionsByUser(ctx, userID)
+}
+
+// RevokeAllSessions - Refresh token'ları DB'de iptal eder ve Redis'e bir "revoked" marker yazar.
+// JWT access token'lar stateless olduğu için marker'dan önce üretilen token'lar
+// AuthMiddleware tarafından reddedilir. Redis backend'indeki opaque session'lar direkt silinir.
+func (s *Service) RevokeAllSessions(ctx context.Context, userID uuid.UUID) error {
+	if err := s.repo.RevokeUserSessions(ctx, userID); err != nil {
+		return err
+	}
+
+	if err := s.revokeOpaqueSessions(ctx, userID); err != nil {
+		return err
+	}
+
+	now := strconv.FormatInt(utils.Now().UnixMilli(), 10)
+	return redis.SetValue(ctx, revokedKey(userID), now, RefreshTokenDuration)
+}
+
+// IsTokenRevoked - Token, kullanıcının son "revoke" işleminden önce üretildiyse true döner.
+// Karşılaştırma milisaniye hassasiyetindedir; revoke'tan hemen sonraki login aynı saniyede olsa da geçerlidir.
+// Redis erişilemezse token geçerli kabul edilir (fail-open); süre zaten kısa.
+func (s *Service) IsTokenRevoked(ctx context.Context, claims *Claims) bool {
+	if claims.IssuedAt == nil {
+		return false
+	}
+
+	val, err := redis.GetValue(ctx, revokedKey(claims.UserID))
+	if err != nil {
+		return false
+	}
+
+	revokedAt, err := strconv.ParseInt(val, 10, 64)
+	if err != nil {
+		return false
+	}
+	// Eski marker'lar saniye cinsinden yazıldı; saniyenin tamamı revoke edilmiş sayılır
+	if revokedAt < 1e12 {
+		revokedAt = revokedAt*1000 + 999
+	}
+
+	// iat_ms olmayan eski token'lar saniyenin başında üretilmiş sayılır
+	issuedAt := claims.IssuedAtMs
+	if issuedAt == 0 {
+		issuedAt = claims.IssuedAt.UnixMilli()
+	}
+
+	return issuedAt < revokedAt
+}
+
+// PreferredLocale - Kullanıcının kayıtlı dil tercihini döner (yoksa "").
+// Her authenticated istekte çağrıldığı için Redis'te cache'lenir; hata durumunda "" döner
+// ve dil header'lardan çözülür.
+func (s *Service) PreferredLocale(ctx context.Context, userID uuid.UUID) string {
+	key := localeKey(userID)
+	if val, err := redis.GetValue(ctx, key); err == nil {
+		return val
+	}
+
+	user, err := s.repo.SelectUserByID(ctx, userID)
+	if err != nil {
+		return ""
+	}
+
+	_ = redis.SetValue(ctx, key, user.Locale, localeCacheTTL)
+	return user.Locale
+}
+
+func (s *Service) UpdateLocale(ctx context.Context, userID uuid.UUID, locale string) error {
+	if err := s.repo.UpdateUserLocale(ctx, userID, locale); err != nil {
+		return err
+	}
+	return redis.DeleteValue(ctx, localeKey(userID))
+}
+
+func (s *Service) MarkPendingDeletion(ctx context.Context, userID uuid.UUID) error {
+	return s.repo.UpdateUserStatus(ctx, userID, UserStatusPendingDeletion)
+}
+
+func (s *Service) MarkActive(ctx context.Context, userID uuid.UUID) error {
+	return s.repo.UpdateUserStatus(ctx, userID, UserStatusActive)
 }
 
-func NewService() *Service {
-	return &Service{}
+// DeleteUser - Kullanıcıyı kalıcı olarak siler ve Redis'teki revoke marker'ını temizler.
+func (s *Service) DeleteUser(ctx context.Context, userID uuid.UUID) error {
+	if err := s.repo.DeleteUser(ctx, userID); err != nil {
+		return err
+	}
+	return redis.DeleteValue(ctx, revokedKey(userID), localeKey(userID))
 }
@@ -0,0 +1,221 @@
+package auth
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/goccy/go-json"
+	"github.com/google/uuid"
+	"github.com/okanay/go-template/pkg/clientip"
+	"github.com/okanay/go-template/pkg/redis"
+	"github.com/okanay/go-template/pkg/utils"
+)
+
+// ═══════════════════════════════════════════════════════════════════
+// SESSION BACKENDS
+// ═══════════════════════════════════════════════════════════════════
+// SESSION_BACKEND=jwt   -> access_token (JWT) + refresh_token cookie'leri (varsayılan)
+// SESSION_BACKEND=redis -> session_id cookie'sinde opaque ID, veri Redis'te.
+//                          Anında iptal edilebilir, her istekte TTL kayar (sliding expiry).
+// Her iki backend de context'e aynı userID / role / authTime değerlerini yazar.
+
+type SessionBackend string
+
+const (
+	SessionBackendJWT   SessionBackend = "jwt"
+	SessionBackendRedis SessionBackend = "redis"
+
+	SessionCookieName = "session_id"
+
+	// SessionIdleTimeout - Bu süre boyunca istek gelmezse session düşer.
+	SessionIdleTimeout = 24 * time.Hour
+	// SessionAbsoluteTimeout - Aktif kullanımda bile session'ın yaşayabileceği maksimum süre.
+	SessionAbsoluteTimeout = 30 * 24 * time.Hour
+
+	sessionIDLength = 48
+)
+
+var ErrSessionNotFound = errors.New("session not found")
+
+// OpaqueSession, Redis backend'inde saklanan session verisi.
+type OpaqueSession struct {
+	UserID    uuid.UUID `json:"userId"`
+	Role      string    `json:"role"`
+	TenantID  string    `json:"tenantId,omitempty"`
+	AuthTime  time.Time `json:"authTime"`
+	IPAddress string    `json:"ipAddress"`
+	UserAgent string    `json:"userAgent"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// SessionBackend - Config'teki SESSION_BACKEND değeri, bilinmeyen değerlerde JWT döner.
+func (s *Service) SessionBackend() SessionBackend {
+	if SessionBackend(s.cfg.SessionBackend) == SessionBackendRedis {
+		return SessionBackendRedis
+	}
+	return SessionBackendJWT
+}
+
+// StartSession - Başarılı login sonrası seçili backend'e göre oturum açar ve cookie'leri yazar.
+func (s *Service) StartSession(c *gin.Context, user *User) error {
+	ctx := c.Request.Context()
+	now := utils.Now()
+
+	if s.SessionBackend() == SessionBackendRedis {
+		sessionID, err := s.CreateOpaqueSession(ctx, &OpaqueSession{
+			UserID:    user.ID,
+			Role:      user.Role,
+			TenantID:  user.TenantID,
+			AuthTime:  now,
+			IPAddress: clientip.Get(c),
+			UserAgent: c.Request.UserAgent(),
+			CreatedAt: now,
+		})
+		if err != nil {
+			return err
+		}
+		s.SetSessionCookie(c, sessionID)
+		return nil
+	}
+
+	accessToken, refreshToken, err := s.GenerateTokens(user, now)
+	if err != nil {
+		return err
+	}
+
+	if err := s.repo.InsertSession(ctx, &Session{
+		ID:        uuid.MustParse(refreshToken),
+		UserID:    user.ID,
+		IPAddress: clientip.Get(c),
+		UserAgent: c.Request.UserAgent(),
+		ExpiresAt: now.Add(RefreshTokenDuration),
+	}); err != nil {
+		return err
+	}
+
+	s.SetCookies(c, accessToken, refreshToken)
+	return nil
+}
+
+// CreateOpaqueSession - Session'ı Redis'e yazar ve cookie'ye konacak opaque ID'yi döner.
+// Redis'te ID'nin kendisi değil SHA-256 hash'i tutulur; dump sızsa bile cookie üretilemez.
+func (s *Service) CreateOpaqueSession(ctx context.Context, session *OpaqueSession) (string, error) {
+	sessionID := utils.GenerateRandomString(sessionIDLength)
+	hashed := hashSessionID(sessionID)
+
+	data, err := json.Marshal(session)
+	if err != nil {
+		return "", err
+	}
+
+	if err := redis.SetValue(ctx, sessionKey(hashed), data, SessionIdleTimeout); err != nil {
+		return "", err
+	}
+
+	// Kullanıcının tüm session'larını iptal edebilmek için index set
+	if err := redis.AddToSet(ctx, userSessionsKey(session.UserID), hashed, SessionAbsoluteTimeout); err != nil {
+		return "", err
+	}
+
+	return sessionID, nil
+}
+
+// GetOpaqueSession - Session'ı okur ve idle TTL'ini yeniler.
+// Absolute timeout aşılmışsa session silinir ve ErrSessionNotFound döner.
+func (s *Service) GetOpaqueSession(ctx context.Context, sessionID string) (*OpaqueSession, error) {
+	if sessionID == "" {
+		return nil, ErrSessionNotFound
+	}
+	hashed := hashSessionID(sessionID)
+
+	val, err := redis.GetValueEx(ctx, sessionKey(hashed), SessionIdleTimeout)
+	if errors.Is(err, redis.ErrNotFound) {
+		return nil, ErrSessionNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var session OpaqueSession
+	if err := json.Unmarshal([]byte(val), &session); err != nil {
+		return nil, err
+	}
+
+	if utils.Now().Sub(session.CreatedAt) > SessionAbsoluteTimeout {
+		_ = s.deleteOpaqueSession(ctx, session.UserID, hashed)
+		return nil, ErrSessionNotFound
+	}
+
+	return &session, nil
+}
+
+// RefreshOpaqueAuthTime - Re-authenticate sonrası session'ın auth_time'ını günceller.
+func (s *Service) RefreshOpaqueAuthTime(ctx context.Context, sessionID string) error {
+	session, err := s.GetOpaqueSession(ctx, sessionID)
+	if err != nil {
+		return err
+	}
+	session.AuthTime = utils.Now()
+
+	data, err := json.Marshal(session)
+	if err != nil {
+		return err
+	}
+	return redis.SetValue(ctx, sessionKey(hashSessionID(sessionID)), data, SessionIdleTimeout)
+}
+
+// DeleteOpaqueSession - Tek bir session'ı siler (logout).
+func (s *Service) DeleteOpaqueSession(ctx context.Context, sessionID string) error {
+	session, err := s.GetOpaqueSession(ctx, sessionID)
+	if err != nil {
+		return err
+	}
+	return s.deleteOpaqueSession(ctx, session.UserID, hashSessionID(sessionID))
+}
+
+func (s *Service) deleteOpaqueSession(ctx context.Context, userID uuid.UUID, hashed string) error {
+	if err := redis.DeleteValue(ctx, sessionKey(hashed)); err != nil {
+		return err
+	}
+	return redis.RemoveFromSet(ctx, userSessionsKey(userID), hashed)
+}
+
+// revokeOpaqueSessions - Kullanıcının Redis'teki tüm session'larını anında siler.
+func (s *Service) revokeOpaqueSessions(ctx context.Context, userID uuid.UUID) error {
+	hashes, err := redis.SetMembers(ctx, userSessionsKey(userID))
+	if err != nil {
+		return err
+	}
+
+	keys := make([]string, 0, len(hashes)+1)
+	for _, h := range hashes {
+		keys = append(keys, sessionKey(h))
+	}
+	keys = append(keys, userSessionsKey(userID))
+
+	return redis.DeleteValue(ctx, keys...)
+}
+
+func (s *Service) SetSessionCookie(c *gin.Context, sessionID string) {
+	// Sliding expiry Redis tarafında; cookie absolute timeout kadar yaşar.
+	c.SetCookie(SessionCookieName, sessionID, int(SessionAbsoluteTimeout.Seconds()), "/", s.cookie.Domain, s.cookie.Secure, true)
+}
+
+func hashSessionID(sessionID string) string {
+	sum := sha256.Sum256([]byte(sessionID))
+	return hex.EncodeToString(sum[:])
+}
+
+// sessionKey -> app:session:<sha256>
+func sessionKey(hashed string) string {
+	return redis.BuildKey("session", hashed)
+}
+
+// userSessionsKey -> app:session:user:<userID>
+func userSessionsKey(userID uuid.UUID) string {
+	return redis.BuildKey("session", "user", userID.String())
+}
@@ -0,0 +1,36 @@
+package corsorigin
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/okanay/go-template/pkg/redis"
+)
+
+type Origin struct {
+	ID        uuid.UUID  `json:"id"`
+	Origin    string     `json:"origin"`
+	TenantID  string     `json:"tenantId"`
+	Note      string     `json:"note"`
+	CreatedBy *uuid.UUID `json:"createdBy,omitempty"`
+	CreatedAt time.Time  `json:"createdAt"`
+}
+
+// OriginSet - Tüm tenant origin'leri. Redis'te tek kayıt olarak tutulur.
+type OriginSet struct {
+	Origins []string `json:"origins"`
+}
+
+func (s OriginSet) GetID() string {
+	return cacheKey
+}
+
+func (s OriginSet) GetDependencies() []redis.Dependency {
+	return nil
+}
+
+type CreateOriginInput struct {
+	Origin   string `json:"origin" validate:"required,max=255"`
+	TenantID string `json:"tenantId" validate:"max=100"`
+	Note     string `json:"note" validate:"max=500"`
+}
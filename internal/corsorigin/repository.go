@@ -0,0 +1,69 @@
+package corsorigin
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+var (
+	ErrOriginNotFound = errors.New("cors origin not found")
+	ErrOriginExists   = errors.New("cors origin already exists")
+)
+
+type Repository struct {
+	db *sql.DB
+}
+
+func NewRepository(db *sql.DB) *Repository {
+	return &Repository{db: db}
+}
+
+func (r *Repository) SelectOrigins(ctx context.Context) ([]Origin, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT id, origin, tenant_id, note, created_by, created_at FROM cors_origins
+		ORDER BY tenant_id, origin`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	origins := []Origin{}
+	for rows.Next() {
+		var o Origin
+		if err := rows.Scan(&o.ID, &o.Origin, &o.TenantID, &o.Note, &o.CreatedBy, &o.CreatedAt); err != nil {
+			return nil, err
+		}
+		origins = append(origins, o)
+	}
+	return origins, rows.Err()
+}
+
+func (r *Repository) InsertOrigin(ctx context.Context, o *Origin) error {
+	err := r.db.QueryRowContext(ctx, `
+		INSERT INTO cors_origins (id, origin, tenant_id, note, created_by)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING created_at`,
+		o.ID, o.Origin, o.TenantID, o.Note, o.CreatedBy).
+		Scan(&o.CreatedAt)
+
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) && pqErr.Code == "23505" {
+		return ErrOriginExists
+	}
+	return err
+}
+
+func (r *Repository) DeleteOrigin(ctx context.Context, id uuid.UUID) error {
+	res, err := r.db.ExecContext(ctx, `DELETE FROM cors_origins WHERE id = $1`, id)
+	if err != nil {
+		return err
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return ErrOriginNotFound
+	}
+	return nil
+}
@@ -0,0 +1,113 @@
+package corsorigin
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/okanay/go-template/configs"
+	"github.com/okanay/go-template/pkg/logger"
+	"github.com/okanay/go-template/pkg/redis"
+)
+
+const (
+	cacheDomain = "cors"
+	cacheKey    = "origins"
+	cacheTTL    = 10 * time.Minute
+)
+
+var ErrInvalidOrigin = errors.New("invalid cors origin")
+
+type Service struct {
+	repo *Repository
+}
+
+func NewService(repo *Repository) *Service {
+	return &Service{repo: repo}
+}
+
+// Allow - configs.CORSPolicy.AllowOrigin olarak kullanılır.
+// Origin'ler okunamazsa istek reddedilir; statik listedeki origin'ler etkilenmez.
+func (s *Service) Allow(c *gin.Context, origin string) bool {
+	ctx := c.Request.Context()
+	set, err := redis.GetItem(ctx, cacheDomain, cacheKey, cacheTTL, redis.GetOptions{},
+		func() (OriginSet, error) {
+			origins, err := s.repo.SelectOrigins(ctx)
+			if err != nil {
+				return OriginSet{}, err
+			}
+			set := OriginSet{Origins: make([]string, len(origins))}
+			for i, o := range origins {
+				set.Origins[i] = o.Origin
+			}
+			return set, nil
+		},
+	)
+	if err != nil {
+		logger.Component("cors").ErrorContext(ctx, "tenant origins could not be loaded", "origin", origin, "error", err)
+		return false
+	}
+
+	for _, pattern := range set.Origins {
+		if configs.MatchOrigin(pattern, origin) {
+			return true
+		}
+	}
+	return false
+}
+
+// ─── Admin ───────────────────────────────────────────────────────
+
+func (s *Service) List(ctx context.Context) ([]Origin, error) {
+	return s.repo.SelectOrigins(ctx)
+}
+
+func (s *Service) Create(ctx context.Context, adminID uuid.UUID, input CreateOriginInput) (*Origin, error) {
+	// "https://App.Customer.com/" -> "https://app.customer.com"
+	value := strings.TrimSuffix(strings.ToLower(strings.TrimSpace(input.Origin)), "/")
+	if value == "*" || configs.ValidateOriginPattern(value) != nil {
+		return nil, ErrInvalidOrigin
+	}
+
+	o := &Origin{
+		ID:        uuid.New(),
+		Origin:    value,
+		TenantID:  input.TenantID,
+		Note:      input.Note,
+		CreatedBy: &adminID,
+	}
+	if err := s.repo.InsertOrigin(ctx, o); err != nil {
+		return nil, err
+	}
+
+	s.invalidate(ctx)
+	logger.Component("cors").InfoContext(ctx, "cors origin created",
+		"origin_id", o.ID.String(),
+		"origin", o.Origin,
+		"tenant_id", o.TenantID,
+		"admin_id", adminID.String(),
+	)
+	return o, nil
+}
+
+func (s *Service) Delete(ctx context.Context, adminID uuid.UUID, id uuid.UUID) error {
+	if err := s.repo.DeleteOrigin(ctx, id); err != nil {
+		return err
+	}
+
+	s.invalidate(ctx)
+	logger.Component("cors").InfoContext(ctx, "cors origin deleted",
+		"origin_id", id.String(),
+		"admin_id", adminID.String(),
+	)
+	return nil
+}
+
+func (s *Service) invalidate(ctx context.Context) {
+	if err := redis.InvalidateEntity(ctx, cacheDomain, cacheKey); err != nil {
+		logger.Component("cors").ErrorContext(ctx, "cors origin cache invalidation failed", "error", err)
+	}
+}
@@ -0,0 +1,55 @@
+package cspreport
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Report - Normalize edilmiş ve aynı ihlallerin toplandığı kayıt.
+type Report struct {
+	ID          uuid.UUID `json:"id"`
+	Type        string    `json:"type"` // "csp-violation", "deprecation", "intervention" ...
+	DocumentURL string    `json:"documentUrl"`
+	BlockedURL  string    `json:"blockedUrl"`
+	Directive   string    `json:"directive"`
+	Disposition string    `json:"disposition"` // "enforce" veya "report"
+	SourceFile  string    `json:"sourceFile"`
+	LineNumber  int       `json:"lineNumber"`
+	Sample      string    `json:"sample"`
+	UserAgent   string    `json:"userAgent"`
+	Count       int64     `json:"count"`
+	FirstSeen   time.Time `json:"firstSeen"`
+	LastSeen    time.Time `json:"lastSeen"`
+}
+
+// legacyReport - Content-Type: application/csp-report (report-uri)
+type legacyReport struct {
+	Body struct {
+		DocumentURI        string `json:"document-uri"`
+		BlockedURI         string `json:"blocked-uri"`
+		ViolatedDirective  string `json:"violated-directive"`
+		EffectiveDirective string `json:"effective-directive"`
+		Disposition        string `json:"disposition"`
+		SourceFile         string `json:"source-file"`
+		LineNumber         int    `json:"line-number"`
+		ScriptSample       string `json:"script-sample"`
+	} `json:"csp-report"`
+}
+
+// apiReport - Content-Type: application/reports+json (Reporting API, report-to). İstek bir dizi taşır.
+type apiReport struct {
+	Type      string `json:"type"`
+	URL       string `json:"url"`
+	UserAgent string `json:"user_agent"`
+	Body      struct {
+		DocumentURL        string `json:"documentURL"`
+		BlockedURL         string `json:"blockedURL"`
+		EffectiveDirective string `json:"effectiveDirective"`
+		Disposition        string `json:"disposition"`
+		SourceFile         string `json:"sourceFile"`
+		LineNumber         int    `json:"lineNumber"`
+		Sample             string `json:"sample"`
+		Message            string `json:"message"` // deprecation / intervention
+	} `json:"body"`
+}
@@ -0,0 +1,90 @@
+package cspreport
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/okanay/go-template/internal/auth"
+	"github.com/okanay/go-template/pkg/apierror"
+)
+
+type Handler struct {
+	service *Service
+}
+
+func NewHandler(service *Service) *Handler {
+	return &Handler{service: service}
+}
+
+// Ingest - POST /csp-report
+// Tarayıcı yanıtı okumaz; geçerli gövdelere her zaman 204 döner.
+func (h *Handler) Ingest(c *gin.Context) {
+	body, err := io.ReadAll(c.Request.Body)
+	if err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			apierror.Error(c, http.StatusRequestEntityTooLarge, apierror.ErrPayloadTooLarge, apierror.MsgPayloadTooLarge)
+			return
+		}
+		apierror.Error(c, http.StatusBadRequest, apierror.ErrBadRequest, "Invalid report payload.")
+		return
+	}
+
+	if _, err := h.service.Ingest(c.Request.Context(), c.ContentType(), body, c.Request.UserAgent()); err != nil {
+		apierror.Error(c, http.StatusBadRequest, apierror.ErrBadRequest, "Invalid report payload.")
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// ListReports - GET /admin/csp-reports?limit=100
+func (h *Handler) ListReports(c *gin.Context) {
+	limit, err := strconv.Atoi(c.DefaultQuery("limit", "100"))
+	if err != nil || limit < 1 || limit > 500 {
+		limit = 100
+	}
+
+	reports, err := h.service.List(c.Request.Context(), limit)
+	if err != nil {
+		apierror.Error(c, http.StatusInternalServerError, apierror.ErrInternal, apierror.MsgInternal)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    reports,
+	})
+}
+
+// DeleteReport - DELETE /admin/csp-reports/:id
+func (h *Handler) DeleteReport(c *gin.Context) {
+	adminID, ok := auth.UserIDFromContext(c)
+	if !ok {
+		apierror.Error(c, http.StatusUnauthorized, apierror.ErrUnauthorized, apierror.MsgUnauthorized)
+		return
+	}
+
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		apierror.Error(c, http.StatusNotFound, apierror.ErrNotFound, apierror.MsgNotFound)
+		return
+	}
+
+	if err := h.service.Delete(c.Request.Context(), adminID, id); err != nil {
+		if errors.Is(err, ErrReportNotFound) {
+			apierror.Error(c, http.StatusNotFound, apierror.ErrNotFound, apierror.MsgNotFound)
+			return
+		}
+		apierror.Error(c, http.StatusInternalServerError, apierror.ErrInternal, apierror.MsgInternal)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+	})
+}
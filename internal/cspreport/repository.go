@@ -0,0 +1,81 @@
+package cspreport
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var ErrReportNotFound = errors.New("csp report not found")
+
+type Repository struct {
+	db *sql.DB
+}
+
+func NewRepository(db *sql.DB) *Repository {
+	return &Repository{db: db}
+}
+
+// UpsertReport - Aynı fingerprint varsa sayacı artırır; sample ve user agent son rapordan alınır.
+func (r *Repository) UpsertReport(ctx context.Context, fingerprint string, rep *Report) error {
+	_, err := r.db.ExecContext(ctx, `
+		INSERT INTO csp_reports (id, fingerprint, type, document_url, blocked_url, directive, disposition, source_file, line_number, sample, user_agent)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+		ON CONFLICT (fingerprint) DO UPDATE SET
+			count      = csp_reports.count + 1,
+			last_seen  = NOW(),
+			sample     = EXCLUDED.sample,
+			user_agent = EXCLUDED.user_agent`,
+		uuid.New(), fingerprint, rep.Type, rep.DocumentURL, rep.BlockedURL, rep.Directive, rep.Disposition,
+		rep.SourceFile, rep.LineNumber, rep.Sample, rep.UserAgent)
+	return err
+}
+
+func (r *Repository) SelectReports(ctx context.Context, limit int) ([]Report, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT id, type, document_url, blocked_url, directive, disposition, source_file, line_number, sample, user_agent, count, first_seen, last_seen
+		FROM csp_reports
+		ORDER BY last_seen DESC
+		LIMIT $1`, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	reports := []Report{}
+	for rows.Next() {
+		var rep Report
+		if err := rows.Scan(&rep.ID, &rep.Type, &rep.DocumentURL, &rep.BlockedURL, &rep.Directive, &rep.Disposition,
+			&rep.SourceFile, &rep.LineNumber, &rep.Sample, &rep.UserAgent, &rep.Count, &rep.FirstSeen, &rep.LastSeen); err != nil {
+			return nil, err
+		}
+		reports = append(reports, rep)
+	}
+	return reports, rows.Err()
+}
+
+// DeleteStaleReports - last_seen'i before'dan eski raporları ve en yeni keep satırın dışında kalanları siler.
+func (r *Repository) DeleteStaleReports(ctx context.Context, before time.Time, keep int) (int64, error) {
+	res, err := r.db.ExecContext(ctx, `
+		DELETE FROM csp_reports
+		WHERE last_seen < $1
+		   OR id NOT IN (SELECT id FROM csp_reports ORDER BY last_seen DESC LIMIT $2)`, before, keep)
+	if err != nil {
+		return 0, err
+	}
+	return res.RowsAffected()
+}
+
+func (r *Repository) DeleteReport(ctx context.Context, id uuid.UUID) error {
+	res, err := r.db.ExecContext(ctx, `DELETE FROM csp_reports WHERE id = $1`, id)
+	if err != nil {
+		return err
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return ErrReportNotFound
+	}
+	return nil
+}
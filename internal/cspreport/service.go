@@ -0,0 +1,184 @@
+package cspreport
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/goccy/go-json"
+	"github.com/google/uuid"
+	"github.com/okanay/go-template/pkg/logger"
+	"github.com/okanay/go-template/pkg/utils"
+)
+
+const (
+	// MaxReportsPerRequest - Reporting API birden fazla raporu tek istekte toplar; fazlası atılır.
+	MaxReportsPerRequest = 20
+	maxFieldLength       = 1024
+
+	// Endpoint kimlik doğrulamasız; saldırganın ürettiği farklı URL'ler tabloyu şişirmesin diye
+	// eski raporlar ve en yeni maxStoredReports dışındakiler PurgeStale ile silinir.
+	reportRetention  = 30 * 24 * time.Hour
+	maxStoredReports = 10000
+)
+
+var ErrInvalidPayload = errors.New("invalid report payload")
+
+type Service struct {
+	repo *Repository
+}
+
+func NewService(repo *Repository) *Service {
+	return &Service{repo: repo}
+}
+
+// Ingest - report-uri (application/csp-report) veya report-to (application/reports+json) gövdesini kaydeder.
+// Kaydedilen rapor sayısını döner. Tek bir raporun kaydedilememesi diğerlerini engellemez.
+func (s *Service) Ingest(ctx context.Context, contentType string, body []byte, userAgent string) (int, error) {
+	reports, err := parse(contentType, body, userAgent)
+	if err != nil {
+		return 0, err
+	}
+
+	stored := 0
+	for i := range reports {
+		rep := &reports[i]
+		if err := s.repo.UpsertReport(ctx, fingerprint(rep), rep); err != nil {
+			logger.Component("csp").ErrorContext(ctx, "csp report could not be stored", "type", rep.Type, "error", err)
+			continue
+		}
+		stored++
+	}
+	return stored, nil
+}
+
+func parse(contentType string, body []byte, userAgent string) ([]Report, error) {
+	if strings.HasPrefix(contentType, "application/reports+json") {
+		var items []apiReport
+		if err := json.Unmarshal(body, &items); err != nil {
+			return nil, ErrInvalidPayload
+		}
+		if len(items) > MaxReportsPerRequest {
+			items = items[:MaxReportsPerRequest]
+		}
+
+		reports := make([]Report, 0, len(items))
+		for _, item := range items {
+			if item.Type == "" {
+				continue
+			}
+			rep := Report{
+				Type:        item.Type,
+				DocumentURL: item.Body.DocumentURL,
+				BlockedURL:  item.Body.BlockedURL,
+				Directive:   item.Body.EffectiveDirective,
+				Disposition: item.Body.Disposition,
+				SourceFile:  item.Body.SourceFile,
+				LineNumber:  item.Body.LineNumber,
+				Sample:      item.Body.Sample,
+				UserAgent:   item.UserAgent,
+			}
+			if rep.DocumentURL == "" {
+				rep.DocumentURL = item.URL
+			}
+			if rep.Sample == "" {
+				rep.Sample = item.Body.Message
+			}
+			reports = append(reports, normalize(rep))
+		}
+		return reports, nil
+	}
+
+	// application/csp-report; bazı tarayıcılar application/json gönderir
+	var legacy legacyReport
+	if err := json.Unmarshal(body, &legacy); err != nil || legacy.Body.DocumentURI == "" {
+		return nil, ErrInvalidPayload
+	}
+	directive := legacy.Body.EffectiveDirective
+	if directive == "" {
+		directive = legacy.Body.ViolatedDirective
+	}
+	return []Report{normalize(Report{
+		Type:        "csp-violation",
+		DocumentURL: legacy.Body.DocumentURI,
+		BlockedURL:  legacy.Body.BlockedURI,
+		Directive:   directive,
+		Disposition: legacy.Body.Disposition,
+		SourceFile:  legacy.Body.SourceFile,
+		LineNumber:  legacy.Body.LineNumber,
+		Sample:      legacy.Body.ScriptSample,
+		UserAgent:   userAgent,
+	})}, nil
+}
+
+// normalize - URL'lerden query/fragment atılır, alanlar kısaltılır.
+func normalize(rep Report) Report {
+	rep.Type = truncate(rep.Type, 64)
+	rep.DocumentURL = truncate(stripQuery(rep.DocumentURL), maxFieldLength)
+	rep.BlockedURL = truncate(stripQuery(rep.BlockedURL), maxFieldLength)
+	rep.SourceFile = truncate(stripQuery(rep.SourceFile), maxFieldLength)
+	rep.Directive = truncate(rep.Directive, 128)
+	rep.Disposition = truncate(rep.Disposition, 16)
+	rep.Sample = truncate(rep.Sample, 256)
+	rep.UserAgent = truncate(rep.UserAgent, 512)
+	return rep
+}
+
+// stripQuery - "inline", "eval" gibi URL olmayan değerler olduğu gibi kalır.
+func stripQuery(raw string) string {
+	u, err := url.Parse(raw)
+	if err != nil || u.Scheme == "" {
+		return raw
+	}
+	u.RawQuery, u.Fragment, u.User = "", "", nil
+	return u.String()
+}
+
+func truncate(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	return strings.ToValidUTF8(s[:n], "")
+}
+
+// fingerprint - Aynı sayfada, aynı kaynağın, aynı direktifi ihlali tek kayıtta toplanır.
+func fingerprint(rep *Report) string {
+	sum := sha256.Sum256([]byte(strings.Join([]string{
+		rep.Type, rep.DocumentURL, rep.BlockedURL, rep.Directive, rep.Disposition, rep.SourceFile,
+	}, "\x00")))
+	return hex.EncodeToString(sum[:])
+}
+
+// ─── Admin ───────────────────────────────────────────────────────
+
+// PurgeStale - Cron job: retention süresini aşan ve sayı sınırının dışında kalan raporları siler.
+func (s *Service) PurgeStale(ctx context.Context) error {
+	deleted, err := s.repo.DeleteStaleReports(ctx, utils.Now().Add(-reportRetention), maxStoredReports)
+	if err != nil {
+		return err
+	}
+	if deleted > 0 {
+		logger.Component("csp").InfoContext(ctx, "stale csp reports purged", "total", deleted)
+	}
+	return nil
+}
+
+func (s *Service) List(ctx context.Context, limit int) ([]Report, error) {
+	return s.repo.SelectReports(ctx, limit)
+}
+
+func (s *Service) Delete(ctx context.Context, adminID uuid.UUID, id uuid.UUID) error {
+	if err := s.repo.DeleteReport(ctx, id); err != nil {
+		return err
+	}
+
+	logger.Component("csp").InfoContext(ctx, "csp report deleted",
+		"report_id", id.String(),
+		"admin_id", adminID.String(),
+	)
+	return nil
+}
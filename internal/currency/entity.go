@@ -0,0 +1,26 @@
+package currency
+
+import (
+	"time"
+
+	"github.com/okanay/go-template/pkg/money"
+	"github.com/okanay/go-template/pkg/redis"
+)
+
+// RateTable - Tek bir base para birimine göre tüm kurlar.
+// Rates değerleri NUMERIC'ten gelen ondalık string'lerdir ("37.512345"); float'a çevrilmez.
+type RateTable struct {
+	Base      money.Currency            `json:"base"`
+	Rates     map[money.Currency]string `json:"rates"`
+	Provider  string                    `json:"provider"`
+	FetchedAt time.Time                 `json:"fetchedAt"`
+}
+
+// GetID - redis.Cacheable. Tek bir "latest" tablo tutulur.
+func (t RateTable) GetID() string {
+	return "latest"
+}
+
+func (t RateTable) GetDependencies() []redis.Dependency {
+	return nil
+}
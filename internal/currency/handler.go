@@ -0,0 +1,52 @@
+package currency
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/okanay/go-template/pkg/apierror"
+	"github.com/okanay/go-template/pkg/money"
+)
+
+type Handler struct {
+	service *Service
+}
+
+func NewHandler(service *Service) *Handler {
+	return &Handler{service: service}
+}
+
+// ListRates - GET /currency/rates
+// İsteğin para birimine (X-Currency) göre 1 birimin diğer birimlerdeki karşılığını döner.
+func (h *Handler) ListRates(c *gin.Context) {
+	ctx := c.Request.Context()
+	current := money.FromContext(ctx)
+
+	table, err := h.service.Rates(ctx)
+	if err != nil {
+		apierror.Error(c, http.StatusServiceUnavailable, apierror.ErrRatesUnavailable, apierror.MsgRatesUnavailable)
+		return
+	}
+
+	rates := make(map[money.Currency]string, len(money.Supported))
+	for _, quote := range money.Supported {
+		if quote == current {
+			continue
+		}
+		rate, err := table.cross(current, quote)
+		if err != nil {
+			continue
+		}
+		rates[quote] = rate.FloatString(6)
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data": gin.H{
+			"base":      current,
+			"rates":     rates,
+			"provider":  table.Provider,
+			"fetchedAt": table.FetchedAt,
+		},
+	})
+}
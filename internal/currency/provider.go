@@ -0,0 +1,228 @@
+package currency
+
+import (
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/okanay/go-template/pkg/money"
+	"github.com/okanay/go-template/pkg/resilience"
+	"github.com/okanay/go-template/pkg/utils"
+)
+
+// Provider - Kur kaynağı. Yeni bir kaynak (TCMB, ücretli API vb.) eklemek için
+// bu arayüzü uygulamak ve main.go'da NewService'e vermek yeterli.
+type Provider interface {
+	// Name - exchange_rates.provider kolonuna ve loglara yazılır.
+	Name() string
+	// Base - Kurların hangi para birimine göre döndüğü (ECB için EUR).
+	Base() money.Currency
+	// FetchRates - 1 birim Base'in money.Supported'daki diğer birimlerdeki karşılığı.
+	FetchRates(ctx context.Context) (*RateTable, error)
+}
+
+// HTTPClient - Lokal geliştirmede gerçek servise gitmemek için stand-in verilebilir.
+// *http.Client bu arayüzü zaten uygular.
+type HTTPClient interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// NewProvider - name: ecb | static (configs.CurrencyConfig).
+// static: staticRates="USD=1.08,TRY=37.50", 1 birim base = X birim.
+func NewProvider(name, base, staticRates string) (Provider, error) {
+	switch strings.ToLower(name) {
+	case "ecb":
+		return NewECBProvider(nil), nil
+	case "static":
+		baseCurrency, err := money.ParseCurrency(base)
+		if err != nil {
+			return nil, err
+		}
+		rates, err := ParseStaticRates(staticRates)
+		if err != nil {
+			return nil, err
+		}
+		return NewStaticProvider(baseCurrency, rates), nil
+	default:
+		return nil, fmt.Errorf("[CURRENCY] :: unknown exchange rate provider")
+	}
+}
+
+// ─── Static ──────────────────────────────────────────────────────
+
+// StaticProvider - Sabit kurlar döner. Lokal geliştirme ve testler için.
+type StaticProvider struct {
+	base  money.Currency
+	rates map[money.Currency]string
+}
+
+func NewStaticProvider(base money.Currency, rates map[money.Currency]string) *StaticProvider {
+	return &StaticProvider{base: base, rates: rates}
+}
+
+func (p *StaticProvider) Name() string {
+	return "static"
+}
+
+func (p *StaticProvider) Base() money.Currency {
+	return p.base
+}
+
+func (p *StaticProvider) FetchRates(ctx context.Context) (*RateTable, error) {
+	rates := make(map[money.Currency]string, len(p.rates))
+	for quote, rate := range p.rates {
+		rates[quote] = rate
+	}
+	return &RateTable{Base: p.base, Rates: rates, Provider: p.Name(), FetchedAt: utils.Now()}, nil
+}
+
+// ParseStaticRates - "USD=1.08,TRY=37.50" -> map. Desteklenmeyen birim veya geçersiz sayı hata döner.
+func ParseStaticRates(s string) (map[money.Currency]string, error) {
+	rates := make(map[money.Currency]string)
+	for _, pair := range strings.Split(s, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+
+		code, rate, ok := strings.Cut(pair, "=")
+		if !ok {
+			return nil, fmt.Errorf("[CURRENCY] :: invalid static rate %q", pair)
+		}
+		quote, err := money.ParseCurrency(code)
+		if err != nil {
+			return nil, fmt.Errorf("[CURRENCY] :: invalid static rate %q: %w", pair, err)
+		}
+		rate = strings.TrimSpace(rate)
+		if r, ok := new(big.Rat).SetString(rate); !ok || r.Sign() <= 0 {
+			return nil, fmt.Errorf("[CURRENCY] :: invalid static rate %q", pair)
+		}
+		rates[quote] = rate
+	}
+	return rates, nil
+}
+
+// ─── European Central Bank ───────────────────────────────────────
+
+// ECBDailyURL - ECB günlük referans kurları (EUR bazlı, iş günlerinde ~16:00 CET güncellenir, API key gerektirmez).
+const ECBDailyURL = "https://www.ecb.europa.eu/stats/eurofxref/eurofxref-daily.xml"
+
+type ECBProvider struct {
+	url    string
+	client HTTPClient
+	dep    *resilience.Dependency
+}
+
+// statusError - ECB'nin 2xx dışı yanıtı. 5xx ve 429 geçici kabul edilip tekrar denenir.
+type statusError struct {
+	code int
+}
+
+func (e *statusError) Error() string {
+	return fmt.Sprintf("[CURRENCY] :: ecb returned status %d", e.code)
+}
+
+func isRetryable(err error) bool {
+	var se *statusError
+	if errors.As(err, &se) {
+		return se.code >= 500 || se.code == http.StatusTooManyRequests
+	}
+	return resilience.IsTransient(err)
+}
+
+// NewECBProvider - client nil ise 10 sn timeout'lu http.Client kullanılır.
+func NewECBProvider(client HTTPClient) *ECBProvider {
+	if client == nil {
+		client = &http.Client{Timeout: 10 * time.Second}
+	}
+	return &ECBProvider{
+		url:    ECBDailyURL,
+		client: client,
+		dep: resilience.New(resilience.Config{
+			Name:          "ecb",
+			Retry:         resilience.RetryPolicy{MaxAttempts: 3, Retryable: isRetryable},
+			MaxConcurrent: 4,
+		}),
+	}
+}
+
+func (p *ECBProvider) Name() string {
+	return "ecb"
+}
+
+func (p *ECBProvider) Base() money.Currency {
+	return money.EUR
+}
+
+// ecbEnvelope - <gesmes:Envelope><Cube><Cube time="2026-10-16"><Cube currency="USD" rate="1.0850"/>...
+type ecbEnvelope struct {
+	Cube struct {
+		Cube struct {
+			Time  string `xml:"time,attr"`
+			Rates []struct {
+				Currency string `xml:"currency,attr"`
+				Rate     string `xml:"rate,attr"`
+			} `xml:"Cube"`
+		} `xml:"Cube"`
+	} `xml:"Cube"`
+}
+
+func (p *ECBProvider) FetchRates(ctx context.Context) (*RateTable, error) {
+	var envelope ecbEnvelope
+
+	// GET idempotent: geçici hatalarda tekrar denemek güvenli
+	err := p.dep.Do(ctx, func(ctx context.Context) error {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, p.url, nil)
+		if err != nil {
+			return resilience.Permanent(err)
+		}
+
+		resp, err := p.client.Do(req)
+		if err != nil {
+			return fmt.Errorf("[CURRENCY] :: ecb request failed: %w", err)
+		}
+		defer resp.Body.Close()
+
+		if resp.StatusCode != http.StatusOK {
+			return &statusError{code: resp.StatusCode}
+		}
+		// Yarım kalan bir denemenin kurları sonraki denemeye karışmasın
+		var decoded ecbEnvelope
+		if err := xml.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+			return fmt.Errorf("[CURRENCY] :: ecb decode failed: %w", err)
+		}
+		envelope = decoded
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	table := &RateTable{
+		Base:      money.EUR,
+		Rates:     make(map[money.Currency]string),
+		Provider:  p.Name(),
+		FetchedAt: utils.Now(),
+	}
+	if t, err := time.Parse(time.DateOnly, envelope.Cube.Cube.Time); err == nil {
+		table.FetchedAt = t
+	}
+
+	for _, r := range envelope.Cube.Cube.Rates {
+		quote, err := money.ParseCurrency(r.Currency)
+		if err != nil {
+			continue // Satış yapmadığımız birimler
+		}
+		table.Rates[quote] = r.Rate
+	}
+
+	if len(table.Rates) == 0 {
+		return nil, fmt.Errorf("[CURRENCY] :: ecb response has no supported currencies")
+	}
+	return table, nil
+}
@@ -0,0 +1,70 @@
+package currency
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/okanay/go-template/pkg/money"
+)
+
+var ErrRatesNotFound = errors.New("exchange rates not found")
+
+type Repository struct {
+	db *sql.DB
+}
+
+func NewRepository(db *sql.DB) *Repository {
+	return &Repository{db: db}
+}
+
+// UpsertRates - Tablonun tamamını tek transaction'da yazar; yarım güncelleme okunmaz.
+func (r *Repository) UpsertRates(ctx context.Context, table *RateTable) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	for quote, rate := range table.Rates {
+		_, err := tx.ExecContext(ctx, `
+			INSERT INTO exchange_rates (base, quote, rate, provider, fetched_at)
+			VALUES ($1, $2, $3, $4, $5)
+			ON CONFLICT (base, quote) DO UPDATE
+				SET rate = EXCLUDED.rate, provider = EXCLUDED.provider, fetched_at = EXCLUDED.fetched_at`,
+			table.Base, quote, rate, table.Provider, table.FetchedAt)
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+// SelectRates - Verilen base için en güncel kurları döner.
+func (r *Repository) SelectRates(ctx context.Context, base money.Currency) (*RateTable, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT quote, rate::TEXT, provider, fetched_at
+		FROM exchange_rates WHERE base = $1`, base)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	table := &RateTable{Base: base, Rates: make(map[money.Currency]string)}
+	for rows.Next() {
+		var quote, rate string
+		if err := rows.Scan(&quote, &rate, &table.Provider, &table.FetchedAt); err != nil {
+			return nil, err
+		}
+		table.Rates[money.Currency(quote)] = rate
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if len(table.Rates) == 0 {
+		return nil, ErrRatesNotFound
+	}
+	return table, nil
+}
@@ -0,0 +1,150 @@
+package currency
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/okanay/go-template/pkg/i18n"
+	"github.com/okanay/go-template/pkg/logger"
+	"github.com/okanay/go-template/pkg/money"
+	"github.com/okanay/go-template/pkg/redis"
+)
+
+const (
+	cacheDomain = "fx"
+	// cacheTTL - Cron saatlik çalışır ve cache'i kendisi yeniler; TTL sadece emniyet payı.
+	cacheTTL = 2 * time.Hour
+)
+
+var ErrRateUnavailable = errors.New("exchange rate unavailable")
+
+// CacheDependency - Kurlara bağlı yanıtlar (ResponseCache) Refresh sonrası temizlenir.
+var CacheDependency = redis.Dependency{Domain: cacheDomain, ID: RateTable{}.GetID()}
+
+// Price - Handler yanıtlarında fiyatın görüntüleme hali.
+// Amount/Currency hesaplama için, Formatted doğrudan ekranda göstermek için.
+type Price struct {
+	money.Money
+	Formatted string `json:"formatted"`
+}
+
+type Service struct {
+	repo     *Repository
+	provider Provider
+}
+
+func NewService(repo *Repository, provider Provider) *Service {
+	return &Service{repo: repo, provider: provider}
+}
+
+// Refresh - Provider'dan güncel kurları çeker, Postgres'e yazar ve Redis cache'ini yeniler.
+// Cron tarafından çağrılır; provider hata verirse son başarılı kurlar kullanılmaya devam eder.
+func (s *Service) Refresh(ctx context.Context) error {
+	table, err := s.provider.FetchRates(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := s.repo.UpsertRates(ctx, table); err != nil {
+		return err
+	}
+
+	// Eski tabloyu ve ona bağlı cache'lenmiş yanıtları (GET /currency/rates) temizle, sonra yenisini yaz
+	if err := redis.InvalidateEntity(ctx, cacheDomain, table.GetID()); err != nil {
+		logger.Component("currency").WarnContext(ctx, "rate cache invalidation failed", "error", err)
+	}
+	if err := redis.UpsertItem(ctx, cacheDomain, *table, cacheTTL, redis.GetOptions{}); err != nil {
+		logger.Component("currency").WarnContext(ctx, "rate cache update failed", "error", err)
+	}
+
+	logger.Component("currency").InfoContext(ctx, "exchange rates refreshed",
+		"provider", table.Provider,
+		"base", table.Base,
+		"count", len(table.Rates),
+	)
+	return nil
+}
+
+// Rates - En güncel kur tablosu (cache-aside: Redis -> Postgres).
+func (s *Service) Rates(ctx context.Context) (*RateTable, error) {
+	table, err := redis.GetItem(ctx, cacheDomain, RateTable{}.GetID(), cacheTTL, redis.GetOptions{},
+		func() (RateTable, error) {
+			t, err := s.repo.SelectRates(ctx, s.provider.Base())
+			if err != nil {
+				return RateTable{}, err
+			}
+			return *t, nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &table, nil
+}
+
+// Rate - 1 birim from'un to cinsinden değeri. Base dışındaki çiftler çapraz kurla hesaplanır:
+// TRY->USD = (EUR->USD) / (EUR->TRY)
+func (s *Service) Rate(ctx context.Context, from, to money.Currency) (*big.Rat, error) {
+	if from == to {
+		return big.NewRat(1, 1), nil
+	}
+
+	table, err := s.Rates(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return table.cross(from, to)
+}
+
+// Convert - Tutarı istenen para birimine çevirir.
+func (s *Service) Convert(ctx context.Context, m money.Money, to money.Currency) (money.Money, error) {
+	rate, err := s.Rate(ctx, m.Currency, to)
+	if err != nil {
+		return money.Money{}, err
+	}
+	return m.Convert(to, rate), nil
+}
+
+// Localize - Tutarı isteğin para birimine (X-Currency) çevirir ve isteğin diline göre formatlar.
+// Handler'larda fiyat döndürmenin standart yolu:
+//
+//	price, err := h.currency.Localize(c.Request.Context(), product.Price)
+func (s *Service) Localize(ctx context.Context, m money.Money) (Price, error) {
+	converted, err := s.Convert(ctx, m, money.FromContext(ctx))
+	if err != nil {
+		return Price{}, err
+	}
+	return Price{Money: converted, Formatted: converted.Format(i18n.FromContext(ctx))}, nil
+}
+
+func (t *RateTable) cross(from, to money.Currency) (*big.Rat, error) {
+	fromRate, err := t.rate(from)
+	if err != nil {
+		return nil, err
+	}
+	toRate, err := t.rate(to)
+	if err != nil {
+		return nil, err
+	}
+	return new(big.Rat).Quo(toRate, fromRate), nil
+}
+
+func (t *RateTable) rate(c money.Currency) (*big.Rat, error) {
+	if c == t.Base {
+		return big.NewRat(1, 1), nil
+	}
+
+	raw, ok := t.Rates[c]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s/%s", ErrRateUnavailable, t.Base, c)
+	}
+
+	r, ok := new(big.Rat).SetString(raw)
+	if !ok || r.Sign() <= 0 {
+		return nil, fmt.Errorf("%w: %s/%s", ErrRateUnavailable, t.Base, c)
+	}
+	return r, nil
+}
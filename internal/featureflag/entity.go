@@ -0,0 +1,47 @@
+package featureflag
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/okanay/go-template/pkg/redis"
+)
+
+// Flag - Bir özelliğin kime açık olduğunu belirleyen kurallar.
+// Boş Roles/Tenants "herkes" demektir; AllowUsers diğer tüm kuralları (enabled hariç) geçer.
+type Flag struct {
+	Key               string      `json:"key"`
+	Description       string      `json:"description"`
+	Enabled           bool        `json:"enabled"`
+	RolloutPercentage int         `json:"rolloutPercentage"`
+	Roles             []string    `json:"roles"`
+	Tenants           []string    `json:"tenants"`
+	AllowUsers        []uuid.UUID `json:"allowUsers"`
+	CreatedAt         time.Time   `json:"createdAt"`
+	UpdatedAt         time.Time   `json:"updatedAt"`
+}
+
+func (f Flag) GetID() string {
+	return f.Key
+}
+
+func (f Flag) GetDependencies() []redis.Dependency {
+	return nil
+}
+
+// EvalContext - Flag'in değerlendirildiği kullanıcı bilgisi. Anonim isteklerde UserID uuid.Nil.
+type EvalContext struct {
+	UserID   uuid.UUID
+	Role     string
+	TenantID string
+}
+
+type FlagInput struct {
+	Key               string      `json:"key" validate:"required,max=100,slug_format"`
+	Description       string      `json:"description" validate:"max=500"`
+	Enabled           bool        `json:"enabled"`
+	RolloutPercentage *int        `json:"rolloutPercentage" validate:"omitempty,gte=0,lte=100"`
+	Roles             []string    `json:"roles" validate:"omitempty,dive,required,max=50"`
+	Tenants           []string    `json:"tenants" validate:"omitempty,dive,required,max=100"`
+	AllowUsers        []uuid.UUID `json:"allowUsers"`
+}
@@ -0,0 +1,111 @@
+package featureflag
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/okanay/go-template/internal/auth"
+	"github.com/okanay/go-template/pkg/apierror"
+	validation "github.com/okanay/go-template/pkg/validator"
+)
+
+type Handler struct {
+	service   *Service
+	validator *validation.Validator
+}
+
+func NewHandler(service *Service, v *validation.Validator) *Handler {
+	return &Handler{
+		service:   service,
+		validator: v,
+	}
+}
+
+// ListFlags - GET /admin/flags
+func (h *Handler) ListFlags(c *gin.Context) {
+	flags, err := h.service.List(c.Request.Context())
+	if err != nil {
+		apierror.Error(c, http.StatusInternalServerError, apierror.ErrInternal, apierror.MsgInternal)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    flags,
+	})
+}
+
+// GetFlag - GET /admin/flags/:key
+func (h *Handler) GetFlag(c *gin.Context) {
+	flag, err := h.service.Get(c.Request.Context(), c.Param("key"))
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    flag,
+	})
+}
+
+// SaveFlag - POST /admin/flags ve PUT /admin/flags/:key
+// PUT'ta key path'ten alınır; body'de key verilirse path ile aynı olmalıdır.
+func (h *Handler) SaveFlag(c *gin.Context) {
+	adminID, ok := auth.UserIDFromContext(c)
+	if !ok {
+		apierror.Error(c, http.StatusUnauthorized, apierror.ErrUnauthorized, apierror.MsgUnauthorized)
+		return
+	}
+
+	// Path'teki key önceden yazılır: body'de key yoksa slug_format kontrolünden path key geçer
+	var input FlagInput
+	pathKey := c.Param("key")
+	input.Key = pathKey
+	if violations := h.validator.BindAndValidate(c, &input, validation.JSON); violations != nil {
+		apierror.ValidationError(c, violations)
+		return
+	}
+	if pathKey != "" && input.Key != pathKey {
+		apierror.Error(c, http.StatusBadRequest, apierror.ErrBadRequest, "The key in the body does not match the URL.")
+		return
+	}
+
+	flag, err := h.service.Save(c.Request.Context(), adminID, input)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    flag,
+	})
+}
+
+// DeleteFlag - DELETE /admin/flags/:key
+func (h *Handler) DeleteFlag(c *gin.Context) {
+	adminID, ok := auth.UserIDFromContext(c)
+	if !ok {
+		apierror.Error(c, http.StatusUnauthorized, apierror.ErrUnauthorized, apierror.MsgUnauthorized)
+		return
+	}
+
+	if err := h.service.Delete(c.Request.Context(), adminID, c.Param("key")); err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+	})
+}
+
+func (h *Handler) handleError(c *gin.Context, err error) {
+	if errors.Is(err, ErrFlagNotFound) {
+		apierror.Error(c, http.StatusNotFound, apierror.ErrNotFound, apierror.MsgNotFound)
+		return
+	}
+	apierror.Error(c, http.StatusInternalServerError, apierror.ErrInternal, apierror.MsgInternal)
+}
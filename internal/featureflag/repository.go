@@ -0,0 +1,114 @@
+package featureflag
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+var ErrFlagNotFound = errors.New("feature flag not found")
+
+type Repository struct {
+	db *sql.DB
+}
+
+func NewRepository(db *sql.DB) *Repository {
+	return &Repository{db: db}
+}
+
+const flagColumns = `key, description, enabled, rollout_percentage, roles, tenants, allow_users, created_at, updated_at`
+
+func (r *Repository) SelectFlag(ctx context.Context, key string) (*Flag, error) {
+	row := r.db.QueryRowContext(ctx, `SELECT `+flagColumns+` FROM feature_flags WHERE key = $1`, key)
+
+	f, err := scanFlag(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrFlagNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+func (r *Repository) SelectFlags(ctx context.Context) ([]Flag, error) {
+	rows, err := r.db.QueryContext(ctx, `SELECT `+flagColumns+` FROM feature_flags ORDER BY key`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var flags []Flag
+	for rows.Next() {
+		f, err := scanFlag(rows)
+		if err != nil {
+			return nil, err
+		}
+		flags = append(flags, *f)
+	}
+	return flags, rows.Err()
+}
+
+// UpsertFlag - Key varsa tüm kuralları günceller, yoksa oluşturur.
+func (r *Repository) UpsertFlag(ctx context.Context, f *Flag) (*Flag, error) {
+	row := r.db.QueryRowContext(ctx, `
+		INSERT INTO feature_flags (key, description, enabled, rollout_percentage, roles, tenants, allow_users)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		ON CONFLICT (key) DO UPDATE SET
+			description = EXCLUDED.description,
+			enabled = EXCLUDED.enabled,
+			rollout_percentage = EXCLUDED.rollout_percentage,
+			roles = EXCLUDED.roles,
+			tenants = EXCLUDED.tenants,
+			allow_users = EXCLUDED.allow_users,
+			updated_at = NOW()
+		RETURNING `+flagColumns,
+		f.Key, f.Description, f.Enabled, f.RolloutPercentage,
+		pq.Array(f.Roles), pq.Array(f.Tenants), pq.Array(uuidStrings(f.AllowUsers)))
+
+	return scanFlag(row)
+}
+
+func (r *Repository) DeleteFlag(ctx context.Context, key string) error {
+	res, err := r.db.ExecContext(ctx, `DELETE FROM feature_flags WHERE key = $1`, key)
+	if err != nil {
+		return err
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return ErrFlagNotFound
+	}
+	return nil
+}
+
+type scanner interface {
+	Scan(dest ...any) error
+}
+
+func scanFlag(s scanner) (*Flag, error) {
+	var f Flag
+	var allowUsers []string
+	err := s.Scan(&f.Key, &f.Description, &f.Enabled, &f.RolloutPercentage,
+		pq.Array(&f.Roles), pq.Array(&f.Tenants), pq.Array(&allowUsers), &f.CreatedAt, &f.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	f.AllowUsers = make([]uuid.UUID, 0, len(allowUsers))
+	for _, id := range allowUsers {
+		if parsed, err := uuid.Parse(id); err == nil {
+			f.AllowUsers = append(f.AllowUsers, parsed)
+		}
+	}
+	return &f, nil
+}
+
+func uuidStrings(ids []uuid.UUID) []string {
+	out := make([]string, len(ids))
+	for i, id := range ids {
+		out[i] = id.String()
+	}
+	return out
+}
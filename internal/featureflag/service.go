@@ -0,0 +1,180 @@
+package featureflag
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/okanay/go-template/internal/auth"
+	"github.com/okanay/go-template/pkg/logger"
+	"github.com/okanay/go-template/pkg/redis"
+)
+
+const (
+	cacheDomain = "flag"
+	cacheTTL    = 10 * time.Minute
+)
+
+type Service struct {
+	repo *Repository
+}
+
+func NewService(repo *Repository) *Service {
+	return &Service{repo: repo}
+}
+
+// IsEnabled - Flag'i verilen kullanıcı için değerlendirir.
+// Tanımsız flag ve okuma hataları false döner: özellik "karanlıkta" kalır.
+func (s *Service) IsEnabled(ctx context.Context, key string, ec EvalContext) bool {
+	flag, err := s.cached(ctx, key)
+	if err != nil {
+		logger.Component("featureflag").WarnContext(ctx, "flag read failed", "flag", key, "error", err)
+		return false
+	}
+	return Evaluate(flag, ec)
+}
+
+// Enabled - Handler içinde kullanım için kısayol:
+//
+//	if h.flags.Enabled(c, "new-checkout") { ... }
+func (s *Service) Enabled(c *gin.Context, key string) bool {
+	return s.IsEnabled(c.Request.Context(), key, EvalContextFrom(c))
+}
+
+// EvalContextFrom - AuthMiddleware'in context'e yazdığı kullanıcı bilgilerinden EvalContext üretir.
+func EvalContextFrom(c *gin.Context) EvalContext {
+	userID, _ := auth.UserIDFromContext(c)
+	return EvalContext{
+		UserID:   userID,
+		Role:     c.GetString("role"),
+		TenantID: auth.TenantIDFromContext(c),
+	}
+}
+
+// Evaluate - Kural sırası:
+//  1. Enabled=false ise herkes için kapalı (kill switch)
+//  2. AllowUsers içindeki kullanıcılar için açık
+//  3. Roles / Tenants doluysa kullanıcı bunlardan birinde olmalı
+//  4. RolloutPercentage < 100 ise kullanıcı ID'sinin hash'i yüzdeye düşmeli
+//     (aynı kullanıcı her zaman aynı sonucu alır; anonimler sadece %100'de görür)
+func Evaluate(f *Flag, ec EvalContext) bool {
+	if f == nil || !f.Enabled {
+		return false
+	}
+
+	if ec.UserID != uuid.Nil && slices.Contains(f.AllowUsers, ec.UserID) {
+		return true
+	}
+
+	if len(f.Roles) > 0 && !slices.Contains(f.Roles, ec.Role) {
+		return false
+	}
+	if len(f.Tenants) > 0 && !slices.Contains(f.Tenants, ec.TenantID) {
+		return false
+	}
+
+	if f.RolloutPercentage >= 100 {
+		return true
+	}
+	if f.RolloutPercentage <= 0 || ec.UserID == uuid.Nil {
+		return false
+	}
+	return bucket(f.Key, ec.UserID) < f.RolloutPercentage
+}
+
+// bucket - sha256(flagKey:userID) -> 0..99
+// Key hash'e dahil edildiği için farklı flag'ler aynı kullanıcı grubuna denk gelmez.
+func bucket(key string, userID uuid.UUID) int {
+	sum := sha256.Sum256([]byte(key + ":" + userID.String()))
+	return int(binary.BigEndian.Uint32(sum[:4]) % 100)
+}
+
+// cached - Tanımsız flag'ler de (Enabled=false olarak) cache'lenir; her istekte DB'ye gidilmez.
+// Save ve Delete InvalidateEntity ile bu kaydı temizler.
+func (s *Service) cached(ctx context.Context, key string) (*Flag, error) {
+	flag, err := redis.GetItem(ctx, cacheDomain, key, cacheTTL, redis.GetOptions{},
+		func() (Flag, error) {
+			f, err := s.repo.SelectFlag(ctx, key)
+			if errors.Is(err, ErrFlagNotFound) {
+				return Flag{Key: key}, nil
+			}
+			if err != nil {
+				return Flag{}, err
+			}
+			return *f, nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &flag, nil
+}
+
+// ─── Admin ───────────────────────────────────────────────────────
+
+func (s *Service) Get(ctx context.Context, key string) (*Flag, error) {
+	return s.repo.SelectFlag(ctx, key)
+}
+
+func (s *Service) List(ctx context.Context) ([]Flag, error) {
+	return s.repo.SelectFlags(ctx)
+}
+
+// Save - Flag'i oluşturur veya tamamen değiştirir (full replace) ve cache'ini (ve ona bağlı yanıtları) temizler.
+// Body'de verilmeyen alanlar varsayılana döner; rolloutPercentage verilmezse %100 (migration ile aynı).
+func (s *Service) Save(ctx context.Context, adminID uuid.UUID, input FlagInput) (*Flag, error) {
+	rollout := 100
+	if input.RolloutPercentage != nil {
+		rollout = *input.RolloutPercentage
+	}
+
+	flag, err := s.repo.UpsertFlag(ctx, &Flag{
+		Key:               input.Key,
+		Description:       input.Description,
+		Enabled:           input.Enabled,
+		RolloutPercentage: rollout,
+		Roles:             nonNil(input.Roles),
+		Tenants:           nonNil(input.Tenants),
+		AllowUsers:        input.AllowUsers,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.invalidate(ctx, flag.Key)
+	logger.Component("featureflag").InfoContext(ctx, "flag saved",
+		"flag", flag.Key,
+		"enabled", flag.Enabled,
+		"rollout", flag.RolloutPercentage,
+		"admin_id", adminID.String(),
+	)
+	return flag, nil
+}
+
+func (s *Service) Delete(ctx context.Context, adminID uuid.UUID, key string) error {
+	if err := s.repo.DeleteFlag(ctx, key); err != nil {
+		return err
+	}
+
+	s.invalidate(ctx, key)
+	logger.Component("featureflag").InfoContext(ctx, "flag deleted", "flag", key, "admin_id", adminID.String())
+	return nil
+}
+
+func (s *Service) invalidate(ctx context.Context, key string) {
+	if err := redis.InvalidateEntity(ctx, cacheDomain, key); err != nil {
+		logger.Component("featureflag").ErrorContext(ctx, "flag cache invalidation failed", "flag", key, "error", err)
+	}
+}
+
+func nonNil(s []string) []string {
+	if s == nil {
+		return []string{}
+	}
+	return s
+}
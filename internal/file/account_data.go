@@ -0,0 +1,72 @@
+package file
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/okanay/go-template/pkg/logger"
+	"github.com/okanay/go-template/pkg/r2"
+)
+
+// AccountData, account modülünün veri export/silme hook'unu file modülü için uygular.
+// Export'a dosya metadata'sı ve R2 linkleri girer; silmede önce R2 objeleri temizlenir.
+type AccountData struct {
+	repo     *Repository
+	r2Client *r2.R2
+}
+
+func NewAccountData(repo *Repository, r2Client *r2.R2) *AccountData {
+	return &AccountData{repo: repo, r2Client: r2Client}
+}
+
+func (a *AccountData) Name() string {
+	return "files"
+}
+
+func (a *AccountData) ExportUserData(ctx context.Context, userID uuid.UUID) (any, error) {
+	files, err := a.repo.SelectFilesByUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if files == nil {
+		files = []File{}
+	}
+	return files, nil
+}
+
+// PurgeUserData - Önce R2 objelerini, sonra DB kayıtlarını siler.
+// Bir obje silinemezse hata döner ve kayıtlar yerinde kalır; PurgeDueAccounts talebi pending'e
+// geri alır ve sonraki çalışmada tekrar dener. R2 DELETE idempotent olduğu için önceden silinmiş
+// objeler sorun olmaz.
+func (a *AccountData) PurgeUserData(ctx context.Context, userID uuid.UUID) error {
+	files, err := a.repo.SelectFilesByUser(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	purged := map[string]bool{}
+	for _, f := range files {
+		if err := a.r2Client.DeleteObject(ctx, f.ObjectKey); err != nil {
+			return err
+		}
+		purged[f.ObjectKey] = true
+	}
+
+	keys, err := a.repo.DeleteFilesByUser(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	// Select ile delete arasında eklenmiş bir kayıt olduysa objesi de silinir
+	for _, key := range keys {
+		if purged[key] {
+			continue
+		}
+		if err := a.r2Client.DeleteObject(ctx, key); err != nil {
+			return err
+		}
+	}
+
+	logger.Component("file").InfoContext(ctx, "r2 objects purged", "user_id", userID, "total", len(keys))
+	return nil
+}
@@ -1 +1,19 @@
 package file
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type File struct {
+	ID          uuid.UUID `json:"id"`
+	UserID      uuid.UUID `json:"userId"`
+	ObjectKey   string    `json:"objectKey"`
+	URL         string    `json:"url"`
+	Filename    string    `json:"filename"`
+	ContentType string    `json:"contentType"`
+	SizeInBytes int64     `json:"sizeInBytes"`
+	Category    string    `json:"category"`
+	CreatedAt   time.Time `json:"createdAt"`
+}
@@ -6,12 +6,14 @@ import (
 )
 
 type Handler struct {
+	repo      *Repository
 	validator *validation.Validator
 	r2Client  *r2.R2
 }
 
-func NewHandler(v *validation.Validator, r2 *r2.R2) *Handler {
+func NewHandler(repo *Repository, v *validation.Validator, r2 *r2.R2) *Handler {
 	return &Handler{
+		repo:      repo,
 		validator: v,
 		r2Client:  r2,
 	}
@@ -4,12 +4,21 @@ import (
 	"net/http"
 
 	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/okanay/go-template/internal/auth"
 	"github.com/okanay/go-template/pkg/apierror"
+	"github.com/okanay/go-template/pkg/logger"
 	"github.com/okanay/go-template/pkg/r2"
 	validation "github.com/okanay/go-template/pkg/validator"
 )
 
 func (h *Handler) CreatePresignedURL(c *gin.Context) {
+	userID, ok := auth.UserIDFromContext(c)
+	if !ok {
+		apierror.Error(c, http.StatusUnauthorized, apierror.ErrUnauthorized, apierror.MsgUnauthorized)
+		return
+	}
+
 	var input r2.UploadInput
 
 	if violations := h.validator.BindAndValidate(c, &input, validation.JSON); violations != nil {
@@ -22,7 +31,23 @@ func (h *Handler) CreatePresignedURL(c *gin.Context) {
 		return
 	}
 
-	// TODO :: Create Database Record Here.
+	// Kayıt upload'dan önce yazılır: client yüklemeyi tamamlamasa da hesap silmede obje key'i
+	// purge listesine girer (R2 DELETE olmayan objelerde de başarılı döner).
+	err = h.repo.InsertNewFile(c.Request.Context(), &File{
+		ID:          uuid.New(),
+		UserID:      userID,
+		ObjectKey:   output.ObjectKey,
+		URL:         output.UploadURL,
+		Filename:    input.Filename,
+		ContentType: input.ContentType,
+		SizeInBytes: input.SizeInBytes,
+		Category:    output.Category,
+	})
+	if err != nil {
+		logger.Component("file").ErrorContext(c.Request.Context(), "file record insert failed", "error", err)
+		apierror.Error(c, http.StatusInternalServerError, apierror.ErrInternal, apierror.MsgInternal)
+		return
+	}
 
 	c.JSON(http.StatusOK, gin.H{
 		"success": true,
@@ -1,9 +1,31 @@
 package file
 
 import (
+	"context"
+
 	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
 )
 
 func (h *Repository) DeleteFile(c *gin.Context) {
 
 }
+
+// DeleteFilesByUser - Kullanıcının tüm dosya kayıtlarını siler ve R2 object key'lerini döner.
+func (h *Repository) DeleteFilesByUser(ctx context.Context, userID uuid.UUID) ([]string, error) {
+	rows, err := h.db.QueryContext(ctx, `DELETE FROM files WHERE user_id = $1 RETURNING object_key`, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var keys []string
+	for rows.Next() {
+		var key string
+		if err := rows.Scan(&key); err != nil {
+			return nil, err
+		}
+		keys = append(keys, key)
+	}
+	return keys, rows.Err()
+}
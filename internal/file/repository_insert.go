@@ -1,9 +1,16 @@
 package file
 
 import (
-	"github.com/gin-gonic/gin"
+	"context"
 )
 
-func (h *Repository) InsertNewFile(c *gin.Context) {
-
+// InsertNewFile - Presigned URL üretildiğinde dosya kaydını oluşturur.
+// Hesap silme ve veri export'u (AccountData) kullanıcının R2 objelerini bu tablodan bulur.
+func (h *Repository) InsertNewFile(ctx context.Context, f *File) error {
+	return h.db.QueryRowContext(ctx, `
+		INSERT INTO files (id, user_id, object_key, url, filename, content_type, size_in_bytes, category)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		RETURNING created_at`,
+		f.ID, f.UserID, f.ObjectKey, f.URL, f.Filename, f.ContentType, f.SizeInBytes, f.Category).
+		Scan(&f.CreatedAt)
 }
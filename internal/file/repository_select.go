@@ -1,9 +1,32 @@
 package file
 
 import (
+	"context"
+
 	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
 )
 
 func (h *Repository) SelectFilesByCategory(c *gin.Context) {
 
 }
+
+func (h *Repository) SelectFilesByUser(ctx context.Context, userID uuid.UUID) ([]File, error) {
+	rows, err := h.db.QueryContext(ctx, `
+		SELECT id, user_id, object_key, url, filename, content_type, size_in_bytes, category, created_at
+		FROM files WHERE user_id = $1 ORDER BY created_at`, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var files []File
+	for rows.Next() {
+		var f File
+		if err := rows.Scan(&f.ID, &f.UserID, &f.ObjectKey, &f.URL, &f.Filename, &f.ContentType, &f.SizeInBytes, &f.Category, &f.CreatedAt); err != nil {
+			return nil, err
+		}
+		files = append(files, f)
+	}
+	return files, rows.Err()
+}
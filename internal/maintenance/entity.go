@@ -0,0 +1,38 @@
+package maintenance
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type Mode string
+
+const (
+	ModeOff         Mode = "off"
+	ModeMaintenance Mode = "maintenance" // Health ve admin dışındaki tüm route'lar 503
+	ModeReadOnly    Mode = "read_only"   // Sadece GET/HEAD/OPTIONS kabul edilir
+)
+
+// State - Redis'te tutulan çalışma modu. Until doluysa mod o anda kendiliğinden kapanır.
+type State struct {
+	Mode      Mode       `json:"mode"`
+	Message   string     `json:"message,omitempty"`
+	Until     *time.Time `json:"until,omitempty"`
+	StartedAt time.Time  `json:"startedAt"`
+	StartedBy uuid.UUID  `json:"startedBy"`
+}
+
+// Active - Mod açık ve süresi dolmamış mı?
+func (s *State) Active(now time.Time) bool {
+	if s == nil || s.Mode == ModeOff {
+		return false
+	}
+	return s.Until == nil || now.Before(*s.Until)
+}
+
+type UpdateStateInput struct {
+	Mode    Mode       `json:"mode" validate:"required,oneof=off maintenance read_only"`
+	Message string     `json:"message" validate:"max=500"`
+	Until   *time.Time `json:"until"` // Opsiyonel, RFC3339. Gelecekte olmalı.
+}
@@ -0,0 +1,67 @@
+package maintenance
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/okanay/go-template/internal/auth"
+	"github.com/okanay/go-template/pkg/apierror"
+	validation "github.com/okanay/go-template/pkg/validator"
+)
+
+type Handler struct {
+	service   *Service
+	validator *validation.Validator
+}
+
+func NewHandler(service *Service, v *validation.Validator) *Handler {
+	return &Handler{
+		service:   service,
+		validator: v,
+	}
+}
+
+// GetState - GET /admin/maintenance
+func (h *Handler) GetState(c *gin.Context) {
+	state := h.service.Current(c.Request.Context())
+	if state == nil {
+		state = &State{Mode: ModeOff}
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    state,
+	})
+}
+
+// UpdateState - PUT /admin/maintenance
+// {"mode": "maintenance", "message": "DB migration", "until": "2026-10-19T03:00:00Z"}
+func (h *Handler) UpdateState(c *gin.Context) {
+	adminID, ok := auth.UserIDFromContext(c)
+	if !ok {
+		apierror.Error(c, http.StatusUnauthorized, apierror.ErrUnauthorized, apierror.MsgUnauthorized)
+		return
+	}
+
+	var input UpdateStateInput
+	if violations := h.validator.BindAndValidate(c, &input, validation.JSON); violations != nil {
+		apierror.ValidationError(c, violations)
+		return
+	}
+
+	state, err := h.service.Update(c.Request.Context(), adminID, input)
+	if err != nil {
+		if errors.Is(err, ErrUntilInPast) {
+			apierror.Error(c, http.StatusBadRequest, apierror.ErrBadRequest, "The end time must be in the future.")
+			return
+		}
+		apierror.Error(c, http.StatusInternalServerError, apierror.ErrInternal, apierror.MsgInternal)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    state,
+	})
+}
@@ -0,0 +1,135 @@
+package maintenance
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/goccy/go-json"
+	"github.com/google/uuid"
+	"github.com/okanay/go-template/pkg/logger"
+	"github.com/okanay/go-template/pkg/redis"
+	"github.com/okanay/go-template/pkg/utils"
+)
+
+const (
+	// localCacheTTL - Her istekte Redis'e gitmemek için state instance içinde kısa süre tutulur.
+	// Admin bir modu açtığında tüm instance'lar en geç bu süre sonunda görür.
+	localCacheTTL = 2 * time.Second
+)
+
+var ErrUntilInPast = errors.New("until must be in the future")
+
+// Service - Bakım / salt-okunur mod durumunu Redis'te yönetir.
+// Redis'e erişilemezse mod kapalı kabul edilir (fail-open): Redis arızası API'yi kapatmamalı.
+type Service struct {
+	mu       sync.RWMutex
+	cached   *State
+	cachedAt time.Time
+	stateKey string
+}
+
+func NewService() *Service {
+	return &Service{stateKey: redis.BuildKey("maintenance", "state")}
+}
+
+// Current - Aktif state'i döner; mod kapalıysa veya süresi dolduysa nil.
+func (s *Service) Current(ctx context.Context) *State {
+	now := utils.Now()
+
+	s.mu.RLock()
+	if now.Sub(s.cachedAt) < localCacheTTL {
+		state := s.cached
+		s.mu.RUnlock()
+		return activeOrNil(state, now)
+	}
+	s.mu.RUnlock()
+
+	state, err := s.load(ctx)
+	if err != nil {
+		logger.Component("maintenance").WarnContext(ctx, "state read failed", "error", err)
+	}
+
+	s.mu.Lock()
+	s.cached, s.cachedAt = state, now
+	s.mu.Unlock()
+
+	return activeOrNil(state, now)
+}
+
+// Update - Modu değiştirir. Until verilirse Redis key'i o anda expire olur; ayrıca
+// bir "kapat" çağrısı gerekmez.
+func (s *Service) Update(ctx context.Context, adminID uuid.UUID, input UpdateStateInput) (*State, error) {
+	now := utils.Now()
+
+	if input.Mode == ModeOff {
+		if err := redis.DeleteValue(ctx, s.stateKey); err != nil {
+			return nil, err
+		}
+		s.invalidateLocal()
+		logger.Component("maintenance").InfoContext(ctx, "mode disabled", "admin_id", adminID.String())
+		return &State{Mode: ModeOff}, nil
+	}
+
+	var ttl time.Duration
+	if input.Until != nil {
+		ttl = input.Until.Sub(now)
+		if ttl <= 0 {
+			return nil, ErrUntilInPast
+		}
+	}
+
+	state := &State{
+		Mode:      input.Mode,
+		Message:   input.Message,
+		Until:     input.Until,
+		StartedAt: now,
+		StartedBy: adminID,
+	}
+
+	data, err := json.Marshal(state)
+	if err != nil {
+		return nil, err
+	}
+	if err := redis.SetValue(ctx, s.stateKey, data, ttl); err != nil {
+		return nil, err
+	}
+	s.invalidateLocal()
+
+	logger.Component("maintenance").InfoContext(ctx, "mode enabled",
+		"mode", string(state.Mode),
+		"admin_id", adminID.String(),
+		"until", input.Until,
+	)
+	return state, nil
+}
+
+func (s *Service) load(ctx context.Context) (*State, error) {
+	val, err := redis.GetValue(ctx, s.stateKey)
+	if errors.Is(err, redis.ErrNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var state State
+	if err := json.Unmarshal([]byte(val), &state); err != nil {
+		return nil, err
+	}
+	return &state, nil
+}
+
+func (s *Service) invalidateLocal() {
+	s.mu.Lock()
+	s.cachedAt = time.Time{}
+	s.mu.Unlock()
+}
+
+func activeOrNil(state *State, now time.Time) *State {
+	if !state.Active(now) {
+		return nil
+	}
+	return state
+}
@@ -0,0 +1,57 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/okanay/go-template/internal/accessrule"
+	"github.com/okanay/go-template/pkg/apierror"
+	"github.com/okanay/go-template/pkg/clientip"
+	"github.com/okanay/go-template/pkg/logger"
+)
+
+// AccessRules - Scope'a (route grubu) tanımlı IP/CIDR ve ülke kurallarını uygular.
+// Kurallar admin endpoint'lerinden çalışma anında değiştirilir; deploy gerekmez.
+// Engellenen her istek loglanır. Kurallar okunamazsa istek 503 ile reddedilir (fail-closed):
+// bu middleware'in koruduğu gruplar (admin vb.) açık kalmaktansa geçici olarak kapanmalıdır.
+// ClientIP middleware'inden sonra çalışmalıdır.
+func (m *Manager) AccessRules(rules *accessrule.Service, scope string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		log := logger.Component("access")
+		ip := clientip.Get(c)
+		country := clientip.CountryFromContext(ctx)
+
+		decision, err := rules.Check(ctx, scope, ip, country)
+		if err != nil {
+			log.ErrorContext(ctx, "access rules unavailable, request rejected",
+				"scope", scope,
+				"client_ip", ip,
+				"path", c.Request.URL.Path,
+				"error", err,
+			)
+			apierror.Error(c, http.StatusServiceUnavailable, apierror.ErrServiceUnavailable, apierror.MsgServiceUnavailable)
+			return
+		}
+
+		if decision.Blocked {
+			attrs := []any{
+				"scope", scope,
+				"reason", decision.Reason,
+				"client_ip", ip,
+				"country", country,
+				"method", c.Request.Method,
+				"path", c.Request.URL.Path,
+			}
+			if decision.Rule != nil {
+				attrs = append(attrs, "rule_id", decision.Rule.ID.String(), "rule", decision.Rule.Value)
+			}
+			log.WarnContext(ctx, "request blocked by access rule", attrs...)
+
+			apierror.Error(c, http.StatusForbidden, apierror.ErrAccessDenied, apierror.MsgAccessDenied)
+			return
+		}
+
+		c.Next()
+	}
+}
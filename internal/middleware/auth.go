@@ -1,11 +1,26 @@
 package middleware
 
 import (
+	"errors"
+	"net/http"
+	"time"
+
 	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
 	"github.com/okanay/go-template/internal/auth"
+	"github.com/okanay/go-template/pkg/apierror"
 )
 
+// AuthMiddleware - SESSION_BACKEND ayarına göre JWT veya Redis session doğrulamasını seçer.
+// Her iki backend de context'e aynı userID / role / authTime değerlerini yazar.
 func (m *Manager) AuthMiddleware() gin.HandlerFunc {
+	if m.authService.SessionBackend() == auth.SessionBackendRedis {
+		return m.sessionAuth()
+	}
+	return m.jwtAuth()
+}
+
+func (m *Manager) jwtAuth() gin.HandlerFunc {
 	return func(c *gin.Context) {
 		accessToken, err := c.Cookie(auth.AccessTokenCookieName)
 		if err != nil {
@@ -13,13 +28,48 @@ func (m *Manager) AuthMiddleware() gin.HandlerFunc {
 			return
 		}
 
-		claims, err := auth.ValidateToken(accessToken)
+		claims, err := m.authService.ValidateToken(accessToken)
 		if err != nil {
 			m.handleTokenRenewal(c)
 			return
 		}
 
-		setContextValues(c, claims)
+		// Oturumlar iptal edildiyse (hesap silme, şifre değişikliği vb.) eski token geçersizdir.
+		if m.authService.IsTokenRevoked(c.Request.Context(), claims) {
+			m.authService.ClearCookies(c)
+			apierror.Error(c, http.StatusUnauthorized, apierror.ErrUnauthorized, "Session revoked, please login again")
+			return
+		}
+
+		setContextValues(c, claims.UserID, claims.Role, claims.TenantID, auth.AuthTimeFromClaims(claims))
+		m.applyUserLocale(c, claims.UserID)
+		c.Next()
+	}
+}
+
+// sessionAuth - Opaque session_id cookie'sini Redis'te doğrular (TTL her istekte kayar).
+// Cookie yoksa istek anonim olarak devam eder; JWT akışıyla aynı davranış.
+func (m *Manager) sessionAuth() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		sessionID, err := c.Cookie(auth.SessionCookieName)
+		if err != nil || sessionID == "" {
+			c.Next()
+			return
+		}
+
+		session, err := m.authService.GetOpaqueSession(c.Request.Context(), sessionID)
+		if err != nil {
+			if errors.Is(err, auth.ErrSessionNotFound) {
+				m.authService.ClearCookies(c)
+				apierror.Error(c, http.StatusUnauthorized, apierror.ErrUnauthorized, "Session expired, please login again")
+				return
+			}
+			apierror.Error(c, http.StatusInternalServerError, apierror.ErrInternal, apierror.MsgInternal)
+			return
+		}
+
+		setContextValues(c, session.UserID, session.Role, session.TenantID, session.AuthTime)
+		m.applyUserLocale(c, session.UserID)
 		c.Next()
 	}
 }
@@ -27,25 +77,28 @@ func (m *Manager) AuthMiddleware() gin.HandlerFunc {
 func (m *Manager) handleTokenRenewal(c *gin.Context) {
 	// refreshToken, err := c.Cookie(auth.RefreshTokenCookieName)
 	// if err != nil {
-	// 	auth.ClearCookies(c)
+	// 	m.authService.ClearCookies(c)
 	// 	apierror.Error(c, http.StatusUnauthorized, apierror.ErrUnauthorized, "Session expired, please login again")
 	// 	return
 	// }
 
 	// newAccess, newRefresh, claims, err := m.authService.RefreshSession(c.Request.Context(), refreshToken)
 	// if err != nil {
-	// 	auth.ClearCookies(c)
+	// 	m.authService.ClearCookies(c)
 	// 	apierror.Error(c, http.StatusUnauthorized, apierror.ErrUnauthorized, apierror.ErrorMessage("Invalid session: "+err.Error()))
 	// 	return
 	// }
 
-	// auth.SetCookies(c, newAccess, newRefresh)
+	// m.authService.SetCookies(c, newAccess, newRefresh)
 
-	// setContextValues(c, claims)
+	// setContextValues(c, claims.UserID, claims.Role, claims.TenantID, auth.AuthTimeFromClaims(claims))
+	// m.applyUserLocale(c, claims.UserID)
 	c.Next()
 }
 
-func setContextValues(c *gin.Context, claims *auth.Claims) {
-	c.Set("userID", claims.UserID)
-	c.Set("role", claims.Role)
+func setContextValues(c *gin.Context, userID uuid.UUID, role, tenantID string, authTime time.Time) {
+	c.Set("userID", userID)
+	c.Set("role", role)
+	c.Set(auth.TenantIDContextKey, tenantID)
+	c.Set("authTime", authTime)
 }
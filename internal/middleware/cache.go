@@ -0,0 +1,271 @@
+package middleware
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/okanay/go-template/internal/auth"
+	"github.com/okanay/go-template/pkg/i18n"
+	"github.com/okanay/go-template/pkg/logger"
+	"github.com/okanay/go-template/pkg/money"
+	"github.com/okanay/go-template/pkg/redis"
+	"github.com/okanay/go-template/pkg/utils"
+)
+
+// ResponseCacheOptions, bir GET route'u için HTTP cache davranışı.
+type ResponseCacheOptions struct {
+	// Store - true ise tam yanıt Redis'te saklanır ve handler çalışmadan döner.
+	// false ise sadece ETag hesaplanır; handler her seferinde çalışır ama body tekrar gönderilmez.
+	Store bool
+	TTL   time.Duration // Store=true için Redis TTL, 0 ise 5 dk
+
+	// Public - true ise yanıt kullanıcıdan bağımsızdır (key'e user ID girmez, Cache-Control: public).
+	Public bool
+	// MaxAge - Tarayıcı/CDN'in revalidate etmeden kullanabileceği süre. 0 ise her seferinde revalidate.
+	MaxAge time.Duration
+
+	// Dependencies - Yanıtın bağlı olduğu entity'ler. InvalidateEntity(domain, id) bu yanıtları da siler.
+	// Örn: func(c) []redis.Dependency { return {{Domain: "blog", ID: c.Param("id")}} }
+	Dependencies func(c *gin.Context) []redis.Dependency
+}
+
+// responseBuffer - Yanıtı client'a göndermeden bellekte tutar.
+// ETag body'den hesaplandığı için header'lar body yazılmadan önce belirlenmelidir.
+type responseBuffer struct {
+	gin.ResponseWriter
+	body   bytes.Buffer
+	status int
+}
+
+func (w *responseBuffer) WriteHeader(code int) {
+	w.status = code
+}
+
+func (w *responseBuffer) WriteHeaderNow() {}
+
+func (w *responseBuffer) Write(b []byte) (int, error) {
+	return w.body.Write(b)
+}
+
+func (w *responseBuffer) WriteString(s string) (int, error) {
+	return w.body.WriteString(s)
+}
+
+func (w *responseBuffer) Status() int {
+	return w.status
+}
+
+func (w *responseBuffer) Size() int {
+	return w.body.Len()
+}
+
+func (w *responseBuffer) Written() bool {
+	return w.body.Len() > 0
+}
+
+// ResponseCache - GET yanıtları için güçlü ETag üretir, If-None-Match / If-Modified-Since
+// ile 304 döner ve opsiyonel olarak tam yanıtı Redis'te saklar.
+// Redis key'i route + query + kullanıcı + dil + para birimine göre ayrılır.
+// Sadece 200 yanıtlar ve Set-Cookie içermeyen yanıtlar cache'lenir.
+func (m *Manager) ResponseCache(opts ResponseCacheOptions) gin.HandlerFunc {
+	if opts.TTL == 0 {
+		opts.TTL = 5 * time.Minute
+	}
+
+	return func(c *gin.Context) {
+		if c.Request.Method != http.MethodGet && c.Request.Method != http.MethodHead {
+			c.Next()
+			return
+		}
+
+		ctx := c.Request.Context()
+		log := logger.Component("cache")
+		key := responseCacheKey(c, opts.Public)
+
+		// 1. Redis'te tam yanıt var mı?
+		if opts.Store {
+			cached, err := redis.GetResponse(ctx, key)
+			if err == nil {
+				c.Header("X-Cache", "HIT")
+				writeCachedResponse(c, opts, cached)
+				return
+			}
+			if !errors.Is(err, redis.ErrNotFound) {
+				log.WarnContext(ctx, "response cache read failed", "error", err)
+			}
+		}
+
+		// 2. Handler'ı buffer ile çalıştır
+		original := c.Writer
+		buffer := &responseBuffer{ResponseWriter: original, status: http.StatusOK}
+		c.Writer = buffer
+		// Handler panic'lerse Recovery'nin 500 yanıtı buffer'da kalmasın
+		defer func() { c.Writer = original }()
+
+		c.Next()
+
+		c.Writer = original
+
+		if buffer.status != http.StatusOK || original.Header().Get("Set-Cookie") != "" {
+			original.WriteHeader(buffer.status)
+			_, _ = original.Write(buffer.body.Bytes())
+			return
+		}
+
+		body := buffer.body.Bytes()
+		lastModified := utils.Now()
+		if lm, err := http.ParseTime(original.Header().Get("Last-Modified")); err == nil {
+			lastModified = lm
+		}
+
+		resp := &redis.CachedResponse{
+			Status:       http.StatusOK,
+			Headers:      cacheableHeaders(original.Header()),
+			Body:         body,
+			ETag:         strongETag(body),
+			LastModified: lastModified,
+		}
+
+		if opts.Store {
+			var deps []redis.Dependency
+			if opts.Dependencies != nil {
+				deps = opts.Dependencies(c)
+			}
+			if err := redis.SetResponse(ctx, key, resp, opts.TTL, deps); err != nil {
+				log.WarnContext(ctx, "response cache write failed", "error", err)
+			}
+			c.Header("X-Cache", "MISS")
+		}
+
+		writeCachedResponse(c, opts, resp)
+	}
+}
+
+// writeCachedResponse - Koşullu istekleri değerlendirir; eşleşirse 304, yoksa tam yanıt yazar.
+func writeCachedResponse(c *gin.Context, opts ResponseCacheOptions, resp *redis.CachedResponse) {
+	h := c.Writer.Header()
+	for name, values := range resp.Headers {
+		h[name] = values
+	}
+	h.Set("ETag", resp.ETag)
+	h.Set("Last-Modified", resp.LastModified.UTC().Format(http.TimeFormat))
+	h.Set("Cache-Control", cacheControl(opts))
+	h.Add("Vary", "Cookie, X-Language, Accept-Language, X-Currency")
+	h.Set("Content-Language", i18n.FromContext(c.Request.Context()))
+
+	if notModified(c.Request, resp) {
+		h.Del("Content-Type")
+		h.Del("Content-Length")
+		c.Writer.WriteHeader(http.StatusNotModified)
+		c.Writer.WriteHeaderNow()
+		c.Abort()
+		return
+	}
+
+	c.Writer.WriteHeader(resp.Status)
+	if c.Request.Method != http.MethodHead {
+		_, _ = c.Writer.Write(resp.Body)
+	} else {
+		c.Writer.WriteHeaderNow()
+	}
+	c.Abort()
+}
+
+// notModified - RFC 9110: If-None-Match varsa If-Modified-Since yok sayılır.
+func notModified(r *http.Request, resp *redis.CachedResponse) bool {
+	if inm := r.Header.Get("If-None-Match"); inm != "" {
+		for _, tag := range strings.Split(inm, ",") {
+			tag = strings.TrimSpace(tag)
+			if tag == "*" || strings.TrimPrefix(tag, "W/") == resp.ETag {
+				return true
+			}
+		}
+		return false
+	}
+
+	if ims := r.Header.Get("If-Modified-Since"); ims != "" {
+		if t, err := http.ParseTime(ims); err == nil {
+			return !resp.LastModified.Truncate(time.Second).After(t)
+		}
+	}
+
+	return false
+}
+
+func cacheControl(opts ResponseCacheOptions) string {
+	visibility := "private"
+	if opts.Public {
+		visibility = "public"
+	}
+	if opts.MaxAge <= 0 {
+		return visibility + ", no-cache"
+	}
+	return fmt.Sprintf("%s, max-age=%d", visibility, int(opts.MaxAge.Seconds()))
+}
+
+// strongETag - Body'nin SHA-256 özeti (ilk 16 byte, base64url).
+func strongETag(body []byte) string {
+	sum := sha256.Sum256(body)
+	return `"` + base64.RawURLEncoding.EncodeToString(sum[:16]) + `"`
+}
+
+// cacheableHeaders - Replay edilecek header'lar. İsteğe özel olanlar (request id, rate limit) saklanmaz.
+func cacheableHeaders(h http.Header) map[string][]string {
+	out := make(map[string][]string)
+	for _, name := range []string{"Content-Type", "Content-Language", "Content-Disposition"} {
+		if v := h.Values(name); len(v) > 0 {
+			out[name] = v
+		}
+	}
+	return out
+}
+
+// responseCacheKey -> app:resp:<sha(route)>:<sha(query|user|locale|currency)>
+func responseCacheKey(c *gin.Context, public bool) string {
+	route := c.FullPath()
+	if route == "" {
+		route = c.Request.URL.Path
+	}
+
+	// Query parametreleri sıralanır: ?b=2&a=1 ile ?a=1&b=2 aynı key'e düşer
+	query := c.Request.URL.Query()
+	names := make([]string, 0, len(query))
+	for name := range query {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var sb strings.Builder
+	sb.WriteString(c.Request.URL.Path)
+	for _, name := range names {
+		values := query[name]
+		sort.Strings(values)
+		sb.WriteString("&" + url.QueryEscape(name) + "=" + url.QueryEscape(strings.Join(values, ",")))
+	}
+
+	sb.WriteString("|user=")
+	if !public {
+		if userID, ok := auth.UserIDFromContext(c); ok {
+			sb.WriteString(userID.String())
+		} else {
+			sb.WriteString("anon")
+		}
+	}
+
+	sb.WriteString("|locale=" + i18n.FromContext(c.Request.Context()))
+	sb.WriteString("|currency=" + string(money.FromContext(c.Request.Context())))
+
+	routeSum := sha256.Sum256([]byte(route))
+	variantSum := sha256.Sum256([]byte(sb.String()))
+	return redis.BuildKeyResponse(hex.EncodeToString(routeSum[:8]), hex.EncodeToString(variantSum[:16]))
+}
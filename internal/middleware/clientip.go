@@ -0,0 +1,26 @@
+package middleware
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/okanay/go-template/pkg/clientip"
+)
+
+// ClientIP - Gerçek istemci IP'sini (ve Cloudflare arkasındaysak ülke kodunu)
+// güvenilir proxy kurallarına göre çözer ve request context'ine yazar. Rate limiter, access log, captcha ve session kayıtları
+// IP'yi clientip.Get(c) ile okur; c.ClientIP() kullanılmamalıdır.
+// RequestID'den hemen sonra, IP kullanan tüm middleware'lerden önce eklenmelidir.
+func (m *Manager) ClientIP(resolver *clientip.Resolver) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ip := resolver.Resolve(c.Request)
+
+		ctx := clientip.NewContext(c.Request.Context(), ip)
+		if country := resolver.Country(c.Request); country != "" {
+			ctx = clientip.NewCountryContext(ctx, country)
+		}
+
+		c.Request = c.Request.WithContext(ctx)
+		c.Set("clientIP", ip)
+
+		c.Next()
+	}
+}
@@ -0,0 +1,25 @@
+package middleware
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/okanay/go-template/pkg/money"
+)
+
+const CurrencyHeader = "X-Currency"
+
+// Currency - İsteğin para birimini X-Currency header'ından çözer ve c.Request.Context()'e yazar.
+// Header yoksa veya desteklenmeyen bir değerse fallback kullanılır; çözülen değer
+// yanıtta X-Currency header'ı ile geri döner ki client hangi birimde fiyat aldığını bilsin.
+// Handler'lar fiyatları currency.Service.Localize ile bu birime çevirir.
+func (m *Manager) Currency(fallback money.Currency) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		currency, err := money.ParseCurrency(c.GetHeader(CurrencyHeader))
+		if err != nil {
+			currency = fallback
+		}
+
+		c.Request = c.Request.WithContext(money.NewContext(c.Request.Context(), currency))
+		c.Header(CurrencyHeader, string(currency))
+		c.Next()
+	}
+}
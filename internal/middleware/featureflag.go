@@ -0,0 +1,22 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/okanay/go-template/internal/featureflag"
+	"github.com/okanay/go-template/pkg/apierror"
+)
+
+// RequireFeature - Flag kullanıcı için kapalıysa route yokmuş gibi 404 döner;
+// karanlıkta geliştirilen özelliklerin varlığı dışarı sızmaz.
+// Kullanıcı hedeflemesi için AuthMiddleware'den sonra kullanılmalıdır.
+func (m *Manager) RequireFeature(flags *featureflag.Service, key string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if !flags.Enabled(c, key) {
+			apierror.Error(c, http.StatusNotFound, apierror.ErrNotFound, apierror.MsgNotFound)
+			return
+		}
+		c.Next()
+	}
+}
@@ -0,0 +1,276 @@
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/goccy/go-json"
+	"github.com/okanay/go-template/internal/auth"
+	"github.com/okanay/go-template/pkg/apierror"
+	"github.com/okanay/go-template/pkg/clientip"
+	"github.com/okanay/go-template/pkg/logger"
+	"github.com/okanay/go-template/pkg/redis"
+	"github.com/okanay/go-template/pkg/requestid"
+)
+
+const (
+	IdempotencyKeyHeader      = "Idempotency-Key"
+	IdempotencyReplayedHeader = "Idempotent-Replayed"
+
+	idempotencyKeyMaxLength = 255
+	// idempotencyLockTTL - İlk istek bu süre içinde bitmezse kilit düşer ve retry çalışabilir.
+	idempotencyLockTTL = time.Minute
+	// idempotencyRecordTTL - Tamamlanan yanıtın replay için saklanma süresi.
+	idempotencyRecordTTL = 24 * time.Hour
+	// idempotencyMaxBody - Bundan büyük yanıtlar saklanmaz (kilit bırakılır).
+	idempotencyMaxBody = 1 << 20
+
+	// cleanupTimeout - Handler bittikten sonraki kilit bırakma / kayıt yazma çağrılarının süresi.
+	cleanupTimeout = 2 * time.Second
+)
+
+// cleanupContext - Handler'dan sonraki Redis çağrıları için. İstek context'i mw.Limits deadline'ı
+// dolduğu için iptal olmuş olabilir; request ID korunur, deadline ve iptal taşınmaz.
+func cleanupContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	return context.WithTimeout(requestid.Detach(ctx), cleanupTimeout)
+}
+
+// Replay edilmeyen header'lar: cookie'ler Redis'e yazılmaz, diğerleri isteğe özeldir.
+var idempotencySkipHeaders = map[string]bool{
+	"Set-Cookie":          true,
+	"X-Request-Id":        true,
+	"Ratelimit-Limit":     true,
+	"Ratelimit-Remaining": true,
+	"Ratelimit-Reset":     true,
+	"Ratelimit-Policy":    true,
+	"Retry-After":         true,
+	"Content-Length":      true,
+}
+
+type idempotencyState string
+
+const (
+	idempotencyProcessing idempotencyState = "processing"
+	idempotencyCompleted  idempotencyState = "completed"
+)
+
+type idempotencyRecord struct {
+	State       idempotencyState    `json:"state"`
+	Fingerprint string              `json:"fingerprint"`
+	Status      int                 `json:"status,omitempty"`
+	Headers     map[string][]string `json:"headers,omitempty"`
+	Body        []byte              `json:"body,omitempty"`
+}
+
+// idempotencyWriter - Handler'ın yazdığı yanıtı client'a gönderirken bir kopyasını tutar.
+type idempotencyWriter struct {
+	gin.ResponseWriter
+	body     bytes.Buffer
+	overflow bool
+}
+
+func (w *idempotencyWriter) Write(b []byte) (int, error) {
+	w.capture(b)
+	return w.ResponseWriter.Write(b)
+}
+
+func (w *idempotencyWriter) WriteString(s string) (int, error) {
+	w.capture([]byte(s))
+	return w.ResponseWriter.WriteString(s)
+}
+
+func (w *idempotencyWriter) capture(b []byte) {
+	if w.overflow {
+		return
+	}
+	if w.body.Len()+len(b) > idempotencyMaxBody {
+		w.overflow = true
+		w.body.Reset()
+		return
+	}
+	w.body.Write(b)
+}
+
+// Idempotency - Idempotency-Key header'ı olan unsafe isteklerin (POST, PATCH, ...) tekrarını önler.
+//
+//   - İlk istek key'i Redis'te kilitler (SET NX), handler çalışır ve yanıt (status, header, body) saklanır.
+//   - Aynı key + aynı payload ile gelen retry'lara saklanan yanıt aynen döner (Idempotent-Replayed: true).
+//   - İlk istek hâlâ işleniyorsa 409, key farklı bir payload ile tekrar kullanılırsa 422 döner.
+//   - 5xx yanıtlar saklanmaz; kilit bırakılır ve client aynı key ile tekrar deneyebilir.
+//
+// Key'ler kullanıcı (yoksa IP) bazında izole edilir. Header yoksa istek normal akar.
+// AuthMiddleware'den sonra kullanılmalıdır.
+func (m *Manager) Idempotency() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		idemKey := strings.TrimSpace(c.GetHeader(IdempotencyKeyHeader))
+		if idemKey == "" || isSafeMethod(c.Request.Method) {
+			c.Next()
+			return
+		}
+
+		if len(idemKey) > idempotencyKeyMaxLength {
+			apierror.Error(c, http.StatusBadRequest, apierror.ErrBadRequest, "Idempotency-Key is too long.")
+			return
+		}
+
+		ctx := c.Request.Context()
+		log := logger.Component("idempotency")
+
+		fingerprint, err := requestFingerprint(c)
+		if err != nil && c.GetBool(apierror.BodyTooLargeKey) {
+			apierror.Error(c, http.StatusRequestEntityTooLarge, apierror.ErrPayloadTooLarge, apierror.MsgPayloadTooLarge)
+			return
+		}
+		if err != nil {
+			apierror.Error(c, http.StatusBadRequest, apierror.ErrBadRequest, apierror.MsgBadRequest)
+			return
+		}
+
+		key := idempotencyRedisKey(c, idemKey)
+
+		// 1. Kilidi almayı dene
+		lock, _ := json.Marshal(idempotencyRecord{State: idempotencyProcessing, Fingerprint: fingerprint})
+		acquired, err := redis.SetValueNX(ctx, key, lock, idempotencyLockTTL)
+		if err != nil {
+			// Redis yoksa idempotency garantisi veremeyiz ama isteği de düşürmüyoruz
+			log.ErrorContext(ctx, "lock failed", "error", err)
+			c.Next()
+			return
+		}
+
+		// 2. Key zaten var -> replay, 409 veya 422
+		if !acquired {
+			handleExistingIdempotencyRecord(c, key, fingerprint)
+			return
+		}
+
+		// 3. İlk istek -> handler'ı çalıştır ve yanıtı yakala
+		writer := &idempotencyWriter{ResponseWriter: c.Writer}
+		c.Writer = writer
+
+		// Handler panic'lerse kilit TTL dolana kadar beklenmez; Recovery 500 döner, client tekrar deneyebilir
+		defer func() {
+			if r := recover(); r != nil {
+				cleanupCtx, cancel := cleanupContext(ctx)
+				defer cancel()
+				if err := redis.DeleteValue(cleanupCtx, key); err != nil {
+					log.ErrorContext(ctx, "unlock failed", "error", err)
+				}
+				panic(r)
+			}
+		}()
+
+		c.Next()
+
+		// Handler timeout'a düştüyse de kilit bırakılmalı / kayıt yazılmalı
+		cleanupCtx, cancel := cleanupContext(ctx)
+		defer cancel()
+
+		status := writer.Status()
+		if status >= http.StatusInternalServerError || writer.overflow {
+			if err := redis.DeleteValue(cleanupCtx, key); err != nil {
+				log.ErrorContext(ctx, "unlock failed", "error", err)
+			}
+			return
+		}
+
+		headers := make(map[string][]string)
+		for name, values := range writer.Header() {
+			if !idempotencySkipHeaders[http.CanonicalHeaderKey(name)] {
+				headers[name] = values
+			}
+		}
+
+		record, _ := json.Marshal(idempotencyRecord{
+			State:       idempotencyCompleted,
+			Fingerprint: fingerprint,
+			Status:      status,
+			Headers:     headers,
+			Body:        writer.body.Bytes(),
+		})
+		if err := redis.SetValue(cleanupCtx, key, record, idempotencyRecordTTL); err != nil {
+			log.ErrorContext(ctx, "record save failed", "error", err)
+		}
+	}
+}
+
+func handleExistingIdempotencyRecord(c *gin.Context, key string, fingerprint string) {
+	val, err := redis.GetValue(c.Request.Context(), key)
+	if err != nil {
+		if errors.Is(err, redis.ErrNotFound) {
+			// Kilit bu arada düştü; client tekrar denemeli
+			c.Header("Retry-After", "1")
+			apierror.Error(c, http.StatusConflict, apierror.ErrIdempotencyInProgress, apierror.MsgIdempotencyInProgress)
+			return
+		}
+		apierror.Error(c, http.StatusInternalServerError, apierror.ErrInternal, apierror.MsgInternal)
+		return
+	}
+
+	var record idempotencyRecord
+	if err := json.Unmarshal([]byte(val), &record); err != nil {
+		apierror.Error(c, http.StatusInternalServerError, apierror.ErrInternal, apierror.MsgInternal)
+		return
+	}
+
+	if record.Fingerprint != fingerprint {
+		apierror.Error(c, http.StatusUnprocessableEntity, apierror.ErrIdempotencyMismatch, apierror.MsgIdempotencyMismatch)
+		return
+	}
+
+	if record.State == idempotencyProcessing {
+		c.Header("Retry-After", "1")
+		apierror.Error(c, http.StatusConflict, apierror.ErrIdempotencyInProgress, apierror.MsgIdempotencyInProgress)
+		return
+	}
+
+	for name, values := range record.Headers {
+		for _, v := range values {
+			c.Writer.Header().Add(name, v)
+		}
+	}
+	c.Header(IdempotencyReplayedHeader, "true")
+	c.Status(record.Status)
+	_, _ = c.Writer.Write(record.Body)
+	c.Abort()
+}
+
+// requestFingerprint - method + route + body hash'i. Body okunduktan sonra handler için geri konur.
+func requestFingerprint(c *gin.Context) (string, error) {
+	var body []byte
+	if c.Request.Body != nil {
+		var err error
+		body, err = io.ReadAll(c.Request.Body)
+		if err != nil {
+			return "", err
+		}
+		c.Request.Body = io.NopCloser(bytes.NewReader(body))
+	}
+
+	h := sha256.New()
+	h.Write([]byte(c.Request.Method + "\n" + c.Request.URL.Path + "\n"))
+	h.Write(body)
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// idempotencyRedisKey -> app:idempotency:user:<id>:<sha256(key)>
+func idempotencyRedisKey(c *gin.Context, idemKey string) string {
+	scope := "ip:" + clientip.Get(c)
+	if userID, ok := auth.UserIDFromContext(c); ok {
+		scope = "user:" + userID.String()
+	}
+	sum := sha256.Sum256([]byte(idemKey))
+	return redis.BuildKey("idempotency", scope, hex.EncodeToString(sum[:]))
+}
+
+func isSafeMethod(method string) bool {
+	return method == http.MethodGet || method == http.MethodHead || method == http.MethodOptions
+}
@@ -0,0 +1,142 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/okanay/go-template/pkg/apierror"
+	"github.com/okanay/go-template/pkg/logger"
+)
+
+// RouteLimits, bir route (veya grup) için body boyutu ve handler süresi sınırları.
+// Sıfır değerli alan "sınır yok" değil, "üst katmandaki değeri koru" anlamına gelir.
+type RouteLimits struct {
+	MaxBodyBytes int64         // Aşılırsa body okuması *http.MaxBytesError ile kesilir ve 413 döner
+	Timeout      time.Duration // c.Request.Context()'e deadline olarak yazılır
+}
+
+// DefaultRouteLimits - router.Use(mw.Limits(DefaultRouteLimits)) ile tüm API'ye uygulanır.
+var DefaultRouteLimits = RouteLimits{
+	MaxBodyBytes: 1 << 20, // 1 MB
+	Timeout:      15 * time.Second,
+}
+
+const (
+	limitsBaseKey   = "limitsBase"
+	limitsActiveKey = "limitsActive" // Deadline'ı geçerli olan (zincirdeki son) Limits'in context'i
+)
+
+// limitsBase - İlk Limits middleware'inden önceki context.
+// Route seviyesindeki Limits, global deadline'ın üstüne eklenmek yerine bunu baz alır;
+// böylece export gibi route'lar global 15 sn'yi büyütebilir.
+type limitsBase struct {
+	ctx context.Context
+}
+
+// valuesContext - Deadline/iptal için base context'i, Value için güncel context'i kullanır.
+// Aradaki middleware'lerin eklediği değerler (dil, kullanıcı vb.) route seviyesindeki
+// Limits'ten sonra kaybolmaz.
+type valuesContext struct {
+	context.Context
+	values context.Context
+}
+
+func (v valuesContext) Value(key any) any {
+	return v.values.Value(key)
+}
+
+// Limits - Body boyutunu sınırlar ve handler'a deadline koyar.
+// Deadline, c.Request.Context() üzerinden R2 (GeneratePresignedURL), repository ve
+// pkg/redis çağrılarına kendiliğinden yayılır; süre dolunca bu çağrılar iptal edilir.
+// Handler yanıt yazmadan deadline dolarsa 504 ErrTimeout döner. Handler kendi 5xx'ini
+// yazsa bile apierror.Error deadline'ı görüp yanıtı 504'e çevirir.
+func (m *Manager) Limits(limits RouteLimits) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		base := baseLimits(c)
+
+		// Content-Length burada reddedilmez: global Limits'in 1 MB'ı, route seviyesindeki
+		// daha büyük bir değerden önce çalışır. Kontrol body ilk okunduğunda yapılır ve
+		// zincirdeki son Limits'in değeri geçerli olur.
+		body := c.Request.Body
+		if lb, ok := body.(*limitedBody); ok {
+			body = lb.original
+		}
+		if limits.MaxBodyBytes > 0 && body != nil && body != http.NoBody {
+			c.Request.Body = &limitedBody{
+				ReadCloser: http.MaxBytesReader(c.Writer, body, limits.MaxBodyBytes),
+				original:   body,
+				c:          c,
+				limit:      limits.MaxBodyBytes,
+			}
+		}
+
+		if limits.Timeout <= 0 {
+			c.Next()
+			return
+		}
+
+		ctx, cancel := context.WithTimeout(valuesContext{Context: base.ctx, values: c.Request.Context()}, limits.Timeout)
+		defer cancel()
+		c.Request = c.Request.WithContext(ctx)
+		c.Set(limitsActiveKey, ctx)
+
+		c.Next()
+
+		// Zincirde sonraki bir Limits deadline'ı değiştirdiyse (route override) karar onundur.
+		// c.Request.Context() ile karşılaştırılmaz: locale gibi middleware'ler context'i WithContext ile sarar.
+		if active, _ := c.Get(limitsActiveKey); active != ctx || !errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			return
+		}
+
+		logger.Component("http").WarnContext(ctx, "handler deadline exceeded",
+			"route", c.FullPath(),
+			"timeout", limits.Timeout.String(),
+		)
+
+		if !c.Writer.Written() {
+			apierror.Error(c, http.StatusGatewayTimeout, apierror.ErrTimeout, apierror.MsgTimeout)
+		}
+	}
+}
+
+// limitedBody - Limit aşıldığında context'e işaret koyar; apierror.ValidationError
+// bu işareti görünce 400 yerine 413 ErrPayloadTooLarge döner.
+type limitedBody struct {
+	io.ReadCloser
+	original io.ReadCloser // Sonraki Limits bunu yeni limitle tekrar sarar
+	c        *gin.Context
+	limit    int64
+	checked  bool
+}
+
+func (b *limitedBody) Read(p []byte) (int, error) {
+	if !b.checked {
+		b.checked = true
+		if b.c.Request.ContentLength > b.limit {
+			b.c.Set(apierror.BodyTooLargeKey, true)
+			return 0, &http.MaxBytesError{Limit: b.limit}
+		}
+	}
+
+	n, err := b.ReadCloser.Read(p)
+	var maxBytesErr *http.MaxBytesError
+	if errors.As(err, &maxBytesErr) {
+		b.c.Set(apierror.BodyTooLargeKey, true)
+	}
+	return n, err
+}
+
+// baseLimits - Zincirdeki ilk Limits çağrısında orijinal context'i saklar.
+func baseLimits(c *gin.Context) *limitsBase {
+	if v, ok := c.Get(limitsBaseKey); ok {
+		return v.(*limitsBase)
+	}
+
+	base := &limitsBase{ctx: c.Request.Context()}
+	c.Set(limitsBaseKey, base)
+	return base
+}
@@ -0,0 +1,36 @@
+package middleware
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/okanay/go-template/pkg/apierror"
+	"github.com/okanay/go-template/pkg/loadshed"
+)
+
+// LoadShed - Route sınıfı başına eşzamanlı istek sayısını sınırlar (pkg/loadshed).
+// Limit doluysa istek kısa süre kuyrukta bekler; yer açılmazsa 503 + Retry-After döner.
+// Aynı sınıftaki route'lar aynı *loadshed.Limiter'ı paylaşmalıdır:
+//
+//	apiLimiter := loadshed.New(loadshed.Config{Name: "api", Limit: 20, ...})
+//	router.Group("/x", mw.LoadShed(apiLimiter))
+func (m *Manager) LoadShed(limiter *loadshed.Limiter) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		release, err := limiter.Acquire(c.Request.Context())
+		if err != nil {
+			// AccessLog'daki "errors" alanında hangi sınıfın yük attığı görünür
+			_ = c.Error(fmt.Errorf("%s: %w", limiter.Name(), err))
+
+			if errors.Is(err, loadshed.ErrShed) {
+				c.Header("Retry-After", "1")
+			}
+			apierror.Error(c, http.StatusServiceUnavailable, apierror.ErrServiceUnavailable, apierror.MsgServiceUnavailable)
+			return
+		}
+		defer release()
+
+		c.Next()
+	}
+}
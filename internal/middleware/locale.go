@@ -0,0 +1,46 @@
+package middleware
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/okanay/go-template/pkg/i18n"
+)
+
+const LanguageHeader = "X-Language"
+
+// Locale - İsteğin dilini çözer ve c.Request.Context()'e yazar.
+// apierror, validator ve ResponseCache dili buradan okur.
+// Öncelik: X-Language > kullanıcı tercihi > Accept-Language (q sırasıyla) > i18n.Default
+// Kullanıcı tercihi bu aşamada bilinmediği için AuthMiddleware tarafından ayrıca uygulanır.
+func (m *Manager) Locale() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		setLocale(c, negotiateLocale(c, ""))
+		c.Next()
+	}
+}
+
+// applyUserLocale - Giriş yapmış kullanıcının kayıtlı dil tercihini uygular.
+// Client X-Language ile açıkça bir dil istediyse tercih yok sayılır.
+func (m *Manager) applyUserLocale(c *gin.Context, userID uuid.UUID) {
+	if _, ok := i18n.Match(c.GetHeader(LanguageHeader)); ok {
+		return
+	}
+
+	preferred := m.authService.PreferredLocale(c.Request.Context(), userID)
+	if preferred == "" {
+		return
+	}
+
+	setLocale(c, negotiateLocale(c, preferred))
+}
+
+func negotiateLocale(c *gin.Context, preferred string) string {
+	candidates := []string{c.GetHeader(LanguageHeader), preferred}
+	candidates = append(candidates, i18n.ParseAcceptLanguage(c.GetHeader("Accept-Language"))...)
+	return i18n.Negotiate(candidates...)
+}
+
+func setLocale(c *gin.Context, lang string) {
+	c.Request = c.Request.WithContext(i18n.NewContext(c.Request.Context(), lang))
+	c.Header("Content-Language", lang)
+}
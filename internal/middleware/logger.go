@@ -1 +1,60 @@
 package middleware
+
+import (
+	"log/slog"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/okanay/go-template/internal/auth"
+	"github.com/okanay/go-template/pkg/clientip"
+	"github.com/okanay/go-template/pkg/logger"
+)
+
+// AccessLog - Her istek için tek satırlık yapılandırılmış log yazar.
+// Header ve cookie'ler hiçbir zaman loglanmaz; query string'deki hassas
+// parametreler ve path'teki token benzeri değerler maskelenir.
+// AuthMiddleware'den önce eklense bile user_id, c.Next() sonrası okunduğu için dolu gelir.
+func (m *Manager) AccessLog() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		start := time.Now()
+
+		c.Next()
+
+		status := c.Writer.Status()
+		route := c.FullPath()
+		if route == "" {
+			route = "unmatched"
+		}
+
+		attrs := []slog.Attr{
+			slog.String("method", c.Request.Method),
+			slog.String("route", route),
+			slog.String("path", c.Request.URL.Path),
+			slog.Int("status", status),
+			slog.Float64("latency_ms", float64(time.Since(start).Microseconds())/1000),
+			slog.Int("bytes", c.Writer.Size()),
+			slog.String("client_ip", clientip.Get(c)),
+			slog.String("user_agent", c.Request.UserAgent()),
+		}
+
+		if q := logger.RedactQuery(c.Request.URL.RawQuery); q != "" {
+			attrs = append(attrs, slog.String("query", q))
+		}
+		if userID, ok := auth.UserIDFromContext(c); ok {
+			attrs = append(attrs, slog.String("user_id", userID.String()))
+		}
+		if len(c.Errors) > 0 {
+			attrs = append(attrs, slog.String("errors", c.Errors.String()))
+		}
+
+		level := slog.LevelInfo
+		switch {
+		case status >= 500:
+			level = slog.LevelError
+		case status >= 400:
+			level = slog.LevelWarn
+		}
+
+		logger.Component("http").LogAttrs(c.Request.Context(), level, "request", attrs...)
+	}
+}
@@ -0,0 +1,79 @@
+package middleware
+
+import (
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/okanay/go-template/internal/maintenance"
+	"github.com/okanay/go-template/pkg/apierror"
+	"github.com/okanay/go-template/pkg/utils"
+)
+
+// maintenanceRetryAfter - Bitiş zamanı belirtilmemiş bakımlarda client'a önerilen bekleme süresi.
+const maintenanceRetryAfter = 5 * time.Minute
+
+// Maintenance - Admin'in açtığı bakım / salt-okunur modu uygular. Her iki mod da
+// 503 + Retry-After döner; salt-okunur modda sadece GET/HEAD/OPTIONS geçer.
+// exempt: Mod açıkken de çalışması gereken path'ler. "/" sadece kendisiyle,
+// "/admin" ise kendisi ve altındaki tüm path'lerle eşleşir.
+func (m *Manager) Maintenance(service *maintenance.Service, exempt ...string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if isExemptPath(c.Request.URL.Path, exempt) {
+			c.Next()
+			return
+		}
+
+		state := service.Current(c.Request.Context())
+		if state == nil {
+			c.Next()
+			return
+		}
+
+		if state.Mode == maintenance.ModeReadOnly && isSafeMethod(c.Request.Method) {
+			c.Next()
+			return
+		}
+
+		c.Header("Retry-After", strconv.Itoa(retryAfterSeconds(state)))
+
+		// Admin'in yazdığı mesaj ve bitiş zamanı SPA'nın bakım ekranında gösterilir
+		var details any
+		if state.Message != "" || state.Until != nil {
+			details = maintenanceDetails{Message: state.Message, Until: state.Until}
+		}
+
+		if state.Mode == maintenance.ModeReadOnly {
+			apierror.ErrorWithDetails(c, http.StatusServiceUnavailable, apierror.ErrReadOnly, apierror.MsgReadOnly, details)
+			return
+		}
+		apierror.ErrorWithDetails(c, http.StatusServiceUnavailable, apierror.ErrMaintenance, apierror.MsgMaintenance, details)
+	}
+}
+
+type maintenanceDetails struct {
+	Message string     `json:"message,omitempty"`
+	Until   *time.Time `json:"until,omitempty"`
+}
+
+func retryAfterSeconds(state *maintenance.State) int {
+	if state.Until == nil {
+		return int(maintenanceRetryAfter.Seconds())
+	}
+	return max(1, int(math.Ceil(state.Until.Sub(utils.Now()).Seconds())))
+}
+
+func isExemptPath(path string, exempt []string) bool {
+	for _, p := range exempt {
+		if path == p {
+			return true
+		}
+		if p != "/" && strings.HasPrefix(path, p+"/") {
+			return true
+		}
+	}
+	return false
+}
@@ -1,13 +1,21 @@
 package middleware
 
-import "github.com/okanay/go-template/internal/auth"
+import (
+	"github.com/okanay/go-template/configs"
+	"github.com/okanay/go-template/internal/auth"
+)
 
 type Manager struct {
 	authService *auth.Service
+	turnstile   configs.TurnstileConfig
+	webhooks    configs.WebhookConfig
 }
 
-func NewManager(authService *auth.Service) *Manager {
+// NewManager - Config bölümleri açılışta okunan değerlerdir; reload'da değişmez.
+func NewManager(authService *auth.Service, turnstile configs.TurnstileConfig, webhooks configs.WebhookConfig) *Manager {
 	return &Manager{
 		authService: authService,
+		turnstile:   turnstile,
+		webhooks:    webhooks,
 	}
 }
@@ -1,7 +1,11 @@
 package middleware
 
 import (
+	"net/http"
+	"slices"
+
 	"github.com/gin-gonic/gin"
+	"github.com/okanay/go-template/pkg/apierror"
 )
 
 func (m *Manager) RequirePermission() gin.HandlerFunc {
@@ -9,3 +13,21 @@ func (m *Manager) RequirePermission() gin.HandlerFunc {
 		c.Next()
 	}
 }
+
+// RequireRole - Kullanıcının rolü verilenlerden biri değilse 403 döner.
+// AuthMiddleware'den sonra kullanılmalıdır.
+func (m *Manager) RequireRole(roles ...string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if _, exists := c.Get("userID"); !exists {
+			apierror.Error(c, http.StatusUnauthorized, apierror.ErrUnauthorized, apierror.MsgUnauthorized)
+			return
+		}
+
+		if !slices.Contains(roles, c.GetString("role")) {
+			apierror.Error(c, http.StatusForbidden, apierror.ErrForbidden, apierror.MsgForbidden)
+			return
+		}
+
+		c.Next()
+	}
+}
@@ -0,0 +1,31 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/okanay/go-template/internal/auth"
+	"github.com/okanay/go-template/pkg/apierror"
+	"github.com/okanay/go-template/pkg/utils"
+)
+
+// RequireRecentAuth - Kullanıcının son şifre/MFA doğrulaması REAUTH_WINDOW_MINUTES'tan
+// eskiyse isteği ErrReauthRequired ile reddeder. Frontend bu key'i görünce
+// şifre onayı ister ve POST /auth/reauthenticate sonrası isteği tekrarlar.
+// AuthMiddleware'den sonra kullanılmalıdır.
+func (m *Manager) RequireRecentAuth() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if _, ok := auth.UserIDFromContext(c); !ok {
+			apierror.Error(c, http.StatusUnauthorized, apierror.ErrUnauthorized, apierror.MsgUnauthorized)
+			return
+		}
+
+		authTime, ok := auth.AuthTimeFromContext(c)
+		if !ok || utils.Now().Sub(authTime) > m.authService.ReauthWindow() {
+			apierror.Error(c, http.StatusForbidden, apierror.ErrReauthRequired, apierror.MsgReauthRequired)
+			return
+		}
+
+		c.Next()
+	}
+}
@@ -0,0 +1,95 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"runtime/debug"
+	"syscall"
+
+	"github.com/gin-gonic/gin"
+	"github.com/okanay/go-template/internal/auth"
+	"github.com/okanay/go-template/pkg/apierror"
+	"github.com/okanay/go-template/pkg/clientip"
+	"github.com/okanay/go-template/pkg/errreport"
+	"github.com/okanay/go-template/pkg/logger"
+	"github.com/okanay/go-template/pkg/requestid"
+	"github.com/okanay/go-template/pkg/utils"
+)
+
+// Recovery - gin.Recovery() yerine kullanılır. Panic'i yakalar, stack trace'i istek
+// bilgileriyle loglar, reporter verilmişse ona iletir ve yanıtı diğer hatalarla aynı
+// şemada (apierror.AppError + requestId) 500 ErrInternal olarak döner.
+// reporter nil olabilir. RequestID ve ClientIP'den sonra, diğer middleware'lerden önce eklenmelidir.
+func (m *Manager) Recovery(reporter errreport.Reporter) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		defer func() {
+			recovered := recover()
+			if recovered == nil {
+				return
+			}
+
+			// net/http sözleşmesi: ErrAbortHandler yanıtı sessizce kesmek içindir, loglanmaz
+			if recovered == http.ErrAbortHandler {
+				panic(recovered)
+			}
+
+			err := errreport.PanicError(recovered)
+			ctx := c.Request.Context()
+
+			// Client bağlantıyı kapattıysa yanıt yazılamaz; stack trace gürültüsü gereksiz
+			if errors.Is(err, syscall.EPIPE) || errors.Is(err, syscall.ECONNRESET) {
+				logger.Component("http").WarnContext(ctx, "client connection closed", "path", c.Request.URL.Path, "error", err)
+				c.Abort()
+				return
+			}
+
+			event := errreport.Event{
+				Err:       err,
+				Stack:     debug.Stack(),
+				Time:      utils.Now(),
+				RequestID: requestid.FromContext(ctx),
+				Method:    c.Request.Method,
+				Route:     c.FullPath(),
+				Path:      c.Request.URL.Path,
+				ClientIP:  clientip.Get(c),
+			}
+			if userID, ok := auth.UserIDFromContext(c); ok {
+				event.UserID = userID.String()
+			}
+
+			logger.Component("http").ErrorContext(ctx, "panic recovered",
+				"error", err.Error(),
+				"method", event.Method,
+				"route", event.Route,
+				"path", event.Path,
+				"client_ip", event.ClientIP,
+				"user_id", event.UserID,
+				"stack", string(event.Stack),
+			)
+
+			if reporter != nil {
+				report(ctx, reporter, event)
+			}
+
+			// Handler yanıtın bir kısmını yazdıysa status değiştirilemez; bağlantı olduğu gibi kapanır
+			if c.Writer.Written() {
+				c.Abort()
+				return
+			}
+			apierror.Error(c, http.StatusInternalServerError, apierror.ErrInternal, apierror.MsgInternal)
+		}()
+
+		c.Next()
+	}
+}
+
+// report - Reporter'ın kendi panic'i isteği (ve recovery'yi) düşürmemeli.
+func report(ctx context.Context, reporter errreport.Reporter, event errreport.Event) {
+	defer func() {
+		if r := recover(); r != nil {
+			logger.Component("http").ErrorContext(ctx, "error reporter panicked", "panic", r)
+		}
+	}()
+	reporter.Report(ctx, event)
+}
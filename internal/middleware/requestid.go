@@ -0,0 +1,25 @@
+package middleware
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/okanay/go-template/pkg/requestid"
+)
+
+// RequestID - X-Request-Id header'ını kabul eder veya yeni bir ID üretir.
+// ID request context'ine, gin context'ine ("requestID") ve yanıt header'ına yazılır.
+// apierror yanıtları ve context ile yazılan tüm log satırları bu ID'yi içerir.
+// Zincirin en başında olmalıdır.
+func (m *Manager) RequestID() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id := c.GetHeader(requestid.Header)
+		if !requestid.IsValid(id) {
+			id = requestid.Generate()
+		}
+
+		c.Request = c.Request.WithContext(requestid.NewContext(c.Request.Context(), id))
+		c.Set("requestID", id)
+		c.Header(requestid.Header, id)
+
+		c.Next()
+	}
+}
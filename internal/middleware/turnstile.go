@@ -1 +1,112 @@
 package middleware
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/okanay/go-template/pkg/apierror"
+	"github.com/okanay/go-template/pkg/captcha"
+	"github.com/okanay/go-template/pkg/clientip"
+	"github.com/okanay/go-template/pkg/logger"
+	"github.com/okanay/go-template/pkg/redis"
+)
+
+// CaptchaTokenHeader - SPA'lar token'ı form alanı yerine bu header ile gönderebilir.
+const CaptchaTokenHeader = "X-Captcha-Token"
+
+// CaptchaConfig, bir route (grubu) için bot koruması ayarları.
+type CaptchaConfig struct {
+	Provider  captcha.Provider // captcha.NewTurnstile(...), NewHCaptcha(...), NewReCaptcha(...)
+	Action    string           // Widget'ta tanımlı action (örn: "contact"), boşsa kontrol edilmez
+	Hostnames []string         // Kabul edilen hostname'ler, boşsa kontrol edilmez
+	CacheTTL  time.Duration    // Başarılı doğrulamaların cache süresi, 0 ise 2 dk
+}
+
+// Captcha - Token'ı header'dan veya form alanından okuyup provider ile doğrular.
+// Turnstile token'ları tek kullanımlık olduğu için başarılı sonuçlar kısa süre Redis'te
+// tutulur; client aynı isteği tekrar gönderirse (retry) siteverify tekrar çağrılmaz.
+// Cache kaydı token'ı doğrulayan IP'ye ve route'un action/hostname kurallarına bağlıdır:
+// başka bir IP veya daha sıkı hostname listesi olan bir route aynı token'ı tekrar kullanamaz.
+func (m *Manager) Captcha(cfg CaptchaConfig) gin.HandlerFunc {
+	if cfg.CacheTTL == 0 {
+		cfg.CacheTTL = 2 * time.Minute
+	}
+
+	return func(c *gin.Context) {
+		token := c.GetHeader(CaptchaTokenHeader)
+		if token == "" && isFormRequest(c) {
+			token = c.PostForm(cfg.Provider.FormField())
+		}
+
+		if token == "" {
+			apierror.Error(c, http.StatusForbidden, apierror.ErrCaptchaFailed, apierror.MsgCaptchaFailed)
+			return
+		}
+
+		ctx := c.Request.Context()
+		cacheKey := captchaCacheKey(cfg.Provider.Name(), cfg, clientip.Get(c), token)
+
+		if _, err := redis.GetValue(ctx, cacheKey); err == nil {
+			c.Next()
+			return
+		}
+
+		result, err := cfg.Provider.Verify(ctx, token, clientip.Get(c))
+		if err != nil {
+			logger.Component("captcha").ErrorContext(ctx, "verify failed", "provider", cfg.Provider.Name(), "error", err)
+			apierror.Error(c, http.StatusServiceUnavailable, apierror.ErrCaptchaUnavailable, apierror.MsgCaptchaUnavailable)
+			return
+		}
+
+		if !result.Success || !matchesCaptchaExpectations(cfg, result) {
+			logger.Component("captcha").WarnContext(ctx, "token rejected",
+				"provider", cfg.Provider.Name(), "hostname", result.Hostname, "action", result.Action, "codes", result.ErrorCodes)
+			apierror.Error(c, http.StatusForbidden, apierror.ErrCaptchaFailed, apierror.MsgCaptchaFailed)
+			return
+		}
+
+		if err := redis.SetValue(ctx, cacheKey, "1", cfg.CacheTTL); err != nil {
+			logger.Component("captcha").ErrorContext(ctx, "cache write failed", "error", err)
+		}
+
+		c.Next()
+	}
+}
+
+// Turnstile - Config'teki TURNSTILE_SECRET_KEY / TURNSTILE_HOSTNAMES değerleriyle Captcha kısayolu.
+// Kullanım: router.POST("/contact", mw.Turnstile("contact"), handler.Contact)
+func (m *Manager) Turnstile(action string) gin.HandlerFunc {
+	return m.Captcha(CaptchaConfig{
+		Provider:  captcha.NewTurnstile(m.turnstile.SecretKey, nil),
+		Action:    action,
+		Hostnames: m.turnstile.Hostnames,
+	})
+}
+
+func matchesCaptchaExpectations(cfg CaptchaConfig, result *captcha.Result) bool {
+	if cfg.Action != "" && result.Action != cfg.Action {
+		return false
+	}
+	if len(cfg.Hostnames) > 0 && !slices.Contains(cfg.Hostnames, result.Hostname) {
+		return false
+	}
+	return true
+}
+
+func isFormRequest(c *gin.Context) bool {
+	ct := c.ContentType()
+	return ct == "application/x-www-form-urlencoded" || strings.HasPrefix(ct, "multipart/form-data")
+}
+
+// captchaCacheKey -> app:captcha:turnstile:<sha256(action:hostnames:ip:token)>
+func captchaCacheKey(provider string, cfg CaptchaConfig, ip, token string) string {
+	hostnames := slices.Clone(cfg.Hostnames)
+	slices.Sort(hostnames)
+	sum := sha256.Sum256([]byte(cfg.Action + ":" + strings.Join(hostnames, ",") + ":" + ip + ":" + token))
+	return redis.BuildKey("captcha", provider, hex.EncodeToString(sum[:]))
+}
@@ -0,0 +1,193 @@
+package middleware
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/okanay/go-template/pkg/apierror"
+	"github.com/okanay/go-template/pkg/clientip"
+	"github.com/okanay/go-template/pkg/logger"
+	"github.com/okanay/go-template/pkg/redis"
+	"github.com/okanay/go-template/pkg/utils"
+	"github.com/okanay/go-template/pkg/webhook"
+)
+
+const (
+	webhookProcessing = "processing"
+	webhookCompleted  = "completed"
+
+	// webhookLockTTL - Handler bu süre içinde bitmezse (process çöktü vb.) kilit düşer ve partnerin retry'ı işlenebilir.
+	webhookLockTTL = 5 * time.Minute
+)
+
+// WebhookConfig, bir webhook kaynağı (partner) için doğrulama ayarları.
+type WebhookConfig struct {
+	Source  string         // Log ve nonce key'lerinde kullanılır: "stripe", "storage". Boşsa Scheme.Name()
+	Scheme  webhook.Scheme // webhook.Stripe{}, webhook.GitHub{} veya özel bir format
+	Secrets []string       // Rotation sırasında eski ve yeni secret birlikte verilir
+
+	// Tolerance - İmzalı timestamp ile sunucu saati arasındaki izin verilen fark. 0 ise 5 dk.
+	Tolerance time.Duration
+	// ReplayTTL - Timestamp içermeyen formatlarda (GitHub) teslimat ID'lerinin saklanma süresi. 0 ise 72 saat.
+	// Timestamp'li formatlarda 2 x Tolerance yeterlidir; daha eski istekler zaten reddedilir.
+	ReplayTTL time.Duration
+}
+
+// Webhook - Gelen webhook'un HMAC-SHA256 imzasını doğrular.
+//   - İmza yok/geçersiz veya timestamp tolerans dışında: 401 ErrInvalidSignature
+//   - Aynı teslimat daha önce işlendiyse handler çalışmaz, 200 döner (partner tekrar denemeyi bırakır)
+//   - İlk teslimat hâlâ işleniyorsa 409 döner; partner daha sonra tekrar dener
+//   - Handler 5xx dönerse veya panic'lerse nonce silinir; partnerin retry'ı tekrar işlenebilir
+//
+// Ham body webhook.RawBody(c) ile okunur, c.Request.Body de tekrar okunabilir halde bırakılır.
+// Nonce'lar Redis'te tutulur; Redis'e erişilemezse replay koruması sağlanamayacağı için
+// 503 döner (fail-closed) ve partner daha sonra tekrar dener.
+func (m *Manager) Webhook(cfg WebhookConfig) gin.HandlerFunc {
+	if cfg.Source == "" {
+		cfg.Source = cfg.Scheme.Name()
+	}
+	if cfg.Tolerance == 0 {
+		cfg.Tolerance = 5 * time.Minute
+	}
+	if cfg.ReplayTTL == 0 {
+		cfg.ReplayTTL = 72 * time.Hour
+	}
+
+	secrets := make([][]byte, 0, len(cfg.Secrets))
+	for _, secret := range cfg.Secrets {
+		if secret = strings.TrimSpace(secret); secret != "" {
+			secrets = append(secrets, []byte(secret))
+		}
+	}
+
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		log := logger.Component("webhook")
+
+		if len(secrets) == 0 {
+			log.ErrorContext(ctx, "webhook secret is not configured", "source", cfg.Source)
+			apierror.Error(c, http.StatusServiceUnavailable, apierror.ErrServiceUnavailable, apierror.MsgServiceUnavailable)
+			return
+		}
+
+		body, err := io.ReadAll(c.Request.Body)
+		if err != nil {
+			if c.GetBool(apierror.BodyTooLargeKey) {
+				apierror.Error(c, http.StatusRequestEntityTooLarge, apierror.ErrPayloadTooLarge, apierror.MsgPayloadTooLarge)
+				return
+			}
+			apierror.Error(c, http.StatusBadRequest, apierror.ErrBadRequest, apierror.MsgBadRequest)
+			return
+		}
+		c.Request.Body = io.NopCloser(bytes.NewReader(body))
+
+		delivery, err := cfg.Scheme.Verify(c.Request.Header, body, secrets, utils.Now(), cfg.Tolerance)
+		if err != nil {
+			log.WarnContext(ctx, "webhook rejected",
+				"source", cfg.Source,
+				"reason", err.Error(),
+				"client_ip", clientip.Get(c),
+			)
+			apierror.Error(c, http.StatusUnauthorized, apierror.ErrInvalidSignature, apierror.MsgInvalidSignature)
+			return
+		}
+
+		ttl := cfg.ReplayTTL
+		if !delivery.Timestamp.IsZero() {
+			ttl = 2 * cfg.Tolerance
+		}
+
+		key := webhookNonceKey(cfg.Source, delivery.ID)
+		fresh, err := redis.SetValueNX(ctx, key, webhookProcessing, webhookLockTTL)
+		if err != nil {
+			log.ErrorContext(ctx, "webhook nonce check failed", "source", cfg.Source, "error", err)
+			apierror.Error(c, http.StatusServiceUnavailable, apierror.ErrServiceUnavailable, apierror.MsgServiceUnavailable)
+			return
+		}
+		if !fresh {
+			handleDuplicateWebhook(c, cfg.Source, key)
+			return
+		}
+
+		c.Set(webhook.RawBodyKey, body)
+
+		// Handler panic'lerse Recovery 500'ü bu frame'den sonra yazar; nonce burada bırakılmalı
+		defer func() {
+			if r := recover(); r != nil {
+				cleanupCtx, cancel := cleanupContext(ctx)
+				defer cancel()
+				if err := redis.DeleteValue(cleanupCtx, key); err != nil {
+					log.ErrorContext(ctx, "webhook nonce release failed", "source", cfg.Source, "error", err)
+				}
+				panic(r)
+			}
+		}()
+
+		c.Next()
+
+		// Handler deadline'ı aştıysa istek context'i iptal olmuştur; nonce yine de güncellenmeli
+		cleanupCtx, cancel := cleanupContext(ctx)
+		defer cancel()
+
+		if c.Writer.Status() >= http.StatusInternalServerError {
+			if err := redis.DeleteValue(cleanupCtx, key); err != nil {
+				log.ErrorContext(ctx, "webhook nonce release failed", "source", cfg.Source, "error", err)
+			}
+			return
+		}
+
+		if err := redis.SetValue(cleanupCtx, key, webhookCompleted, ttl); err != nil {
+			log.ErrorContext(ctx, "webhook nonce save failed", "source", cfg.Source, "error", err)
+		}
+	}
+}
+
+// handleDuplicateWebhook - Tamamlanmış teslimat 200 ile onaylanır. İşlenmekte olan (veya kilidi
+// bu arada düşen) teslimat 409 alır; ilk deneme başarısız olursa partnerin retry'ı olayı kaybetmez.
+func handleDuplicateWebhook(c *gin.Context, source, key string) {
+	ctx := c.Request.Context()
+	log := logger.Component("webhook")
+
+	state, err := redis.GetValue(ctx, key)
+	if err != nil && !errors.Is(err, redis.ErrNotFound) {
+		log.ErrorContext(ctx, "webhook nonce read failed", "source", source, "error", err)
+		apierror.Error(c, http.StatusServiceUnavailable, apierror.ErrServiceUnavailable, apierror.MsgServiceUnavailable)
+		return
+	}
+
+	if state == webhookCompleted {
+		log.WarnContext(ctx, "webhook replay ignored", "source", source, "client_ip", clientip.Get(c))
+		c.AbortWithStatusJSON(http.StatusOK, gin.H{
+			"success":   true,
+			"duplicate": true,
+		})
+		return
+	}
+
+	log.WarnContext(ctx, "webhook delivery in progress", "source", source, "client_ip", clientip.Get(c))
+	c.Header("Retry-After", "5")
+	apierror.Error(c, http.StatusConflict, apierror.ErrWebhookInProgress, apierror.MsgWebhookInProgress)
+}
+
+// WebhookFromConfig - Secret'ları Config'teki WEBHOOK_<SOURCE>_SECRETS değerinden okur (configs.WebhookConfig).
+// Kullanım: router.POST("/webhooks/stripe", mw.WebhookFromConfig("stripe", webhook.Stripe{}), handler.Stripe)
+func (m *Manager) WebhookFromConfig(source string, scheme webhook.Scheme) gin.HandlerFunc {
+	return m.Webhook(WebhookConfig{
+		Source:  source,
+		Scheme:  scheme,
+		Secrets: m.webhooks.Secrets(source),
+	})
+}
+
+// webhookNonceKey -> app:webhook:stripe:<sha256(id)>
+func webhookNonceKey(source, id string) string {
+	sum := sha256.Sum256([]byte(id))
+	return redis.BuildKey("webhook", source, hex.EncodeToString(sum[:16]))
+}
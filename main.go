@@ -1,60 +1,106 @@
 package main
 
 import (
+	"context"
+	"errors"
 	"fmt"
-	"log"
-	"os"
+	"net/http"
+	"strconv"
+	"time"
 
 	"github.com/gin-gonic/gin"
-	"github.com/joho/godotenv"
 
 	"github.com/okanay/go-template/configs"
+	"github.com/okanay/go-template/internal/accessrule"
+	"github.com/okanay/go-template/internal/account"
+	"github.com/okanay/go-template/internal/auth"
+	"github.com/okanay/go-template/internal/corsorigin"
+	"github.com/okanay/go-template/internal/cspreport"
+	"github.com/okanay/go-template/internal/currency"
+	"github.com/okanay/go-template/internal/featureflag"
+	"github.com/okanay/go-template/internal/file"
+	"github.com/okanay/go-template/internal/maintenance"
+	"github.com/okanay/go-template/internal/middleware"
+	"github.com/okanay/go-template/pkg/clientip"
+	"github.com/okanay/go-template/pkg/crons"
 	"github.com/okanay/go-template/pkg/database"
+	"github.com/okanay/go-template/pkg/errreport"
+	"github.com/okanay/go-template/pkg/loadshed"
+	"github.com/okanay/go-template/pkg/logger"
+	"github.com/okanay/go-template/pkg/money"
+	"github.com/okanay/go-template/pkg/r2"
 	"github.com/okanay/go-template/pkg/redis"
+	"github.com/okanay/go-template/pkg/resilience"
+	validation "github.com/okanay/go-template/pkg/validator"
 )
 
 func main() {
 	// -------------------------------------------------------------------------
-	// 1. ENVIRONMENT VARIABLES - .env dosyasını yükle
+	// 1. CONFIGURATION - env + .env + default'lar, tek bir typed struct (configs.Config)
 	// -------------------------------------------------------------------------
-	// godotenv.Load() başarısız olursa sistem environment variable'ları kullanılır.
-	// Bu durum production ortamında normaldir (Docker, Kubernetes vb.)
-	if err := godotenv.Load(); err != nil {
-		log.Println("[MAIN::ENV] :: .env file not found, system environment variables will be used.")
+	// .env yoksa sistem environment variable'ları kullanılır; production'da (Docker,
+	// Kubernetes vb.) bu normaldir. Eksik/hatalı değerler loglanıp uygulama durdurulur.
+	cfg, cfgErr := configs.Load(".env")
+
+	// -------------------------------------------------------------------------
+	// 1.1 LOGGER - slog tabanlı yapılandırılmış loglama
+	// -------------------------------------------------------------------------
+	// LOG_FORMAT (json/text) ve LOG_LEVEL config'ten okunur. Config geçersizse hataları yazabilmek
+	// için sistem env'iyle kurulur. Cookie, Authorization header'ı ve secret içeren alanlar
+	// handler seviyesinde maskelenir.
+	logCfg := logger.ConfigFromEnv()
+	if cfg != nil {
+		logCfg = cfg.Logger()
+	}
+	logger.Init(logCfg)
+	mainLog := logger.Component("main")
+
+	var loadErr *configs.LoadError
+	if errors.As(cfgErr, &loadErr) {
+		logger.Fatal(mainLog, "invalid configuration", "problems", loadErr.Problems)
 	}
+	if cfgErr != nil {
+		logger.Fatal(mainLog, "failed to load configuration", "error", cfgErr)
+	}
+
+	gin.SetMode(cfg.Server.Mode)
+	mainLog.Info("configuration loaded", "config", cfg)
+
+	// Reload edilebilen bölümler (CORS, Security, RateLimit, Log) SIGHUP veya .env değişikliğinde
+	// restart olmadan güncellenir. Bu bölümleri okuyan kod cfg yerine cfgStore.Current() veya
+	// configs.Dynamic kullanmalı. Geçersiz değişiklikler loglanır, önceki config korunur.
+	cfgStore := configs.NewStore(cfg, ".env")
+	logger.SetLevel(logger.ParseLevel(cfg.Log.Level))
+	cfgStore.Subscribe(func(_, next *configs.Config) {
+		logger.SetLevel(logger.ParseLevel(next.Log.Level))
+	})
 
 	// -------------------------------------------------------------------------
 	// 2. DATABASE CONNECTION - PostgreSQL bağlantısı
 	// -------------------------------------------------------------------------
-	// Veritabanı bağlantı string'i DB_MAIN_CONN_STRING env variable'ından alınır.
+	// Bağlantı string'i MAIN_CONN_STRING'den alınır (eski DB_MAIN_CONN_STRING de kabul edilir).
 	// Bağlantı başarısız olursa uygulama tamamen durur (Fatalf).
-	db, err := database.NewPostgres(os.Getenv("DB_MAIN_CONN_STRING"))
+	db, err := database.NewPostgres(cfg.Database.MainConnString)
 	if err != nil {
-		log.Fatalf("[DB::ERROR] :: Failed to connect to database: %v", err)
+		logger.Fatal(logger.Component("db"), "failed to connect to database", "error", err)
 	}
 
 	// defer ile main fonksiyonu bittiğinde veritabanı bağlantısı kapatılır.
 	// Bu, resource leak'i önlemek için kritik öneme sahiptir.
 	defer db.Close()
 
-	log.Println("[DB::SUCCESS] :: Successfully connected to the database.")
+	logger.Component("db").Info("connected to database")
 
 	// -------------------------------------------------------------------------
 	// 3. REDIS CONNECTION - Redis bağlantısı ve konfigürasyonu
 	// -------------------------------------------------------------------------
-	// Redis bağlantı bilgileri environment variable'lardan okunur.
-	redisAddr := os.Getenv("REDIS_ADDR")
-	redisUsername := os.Getenv("REDIS_USERNAME")
-	redisPass := os.Getenv("REDIS_PASS")
-	redisDB := os.Getenv("REDIS_DB")
-
 	// Redis client'ı initialize edilir.
 	// İlk parametre slice olarak geçiyor - cluster desteği için tasarlanmış.
 	err = redis.Initialize(
-		[]string{redisAddr},
-		redisUsername,
-		redisPass,
-		redisDB,
+		[]string{cfg.Redis.Addr},
+		cfg.Redis.Username,
+		cfg.Redis.Password,
+		strconv.Itoa(cfg.Redis.DB),
 	)
 
 	// -------------------------------------------------------------------------
@@ -62,13 +108,13 @@ func main() {
 	// -------------------------------------------------------------------------
 	// GIN_MODE=debug ise tüm Redis cache'i temizlenir.
 	// Bu, development sırasında eski/stale cache verilerini önler.
-	if os.Getenv("GIN_MODE") == "debug" {
-		log.Println("[REDIS::INFO] :: DEV MODE: Clearing Redis cache...")
+	if cfg.IsDebug() {
+		logger.Component("redis").Info("dev mode: clearing redis cache")
 
 		if err := redis.InvalidateFlushDB(); err != nil {
-			log.Printf("[REDIS::ERROR] :: Error clearing cache: %v", err)
+			logger.Component("redis").Error("failed to clear cache", "error", err)
 		} else {
-			log.Println("[REDIS::SUCCESS] :: DEV MODE: Redis cache cleared successfully.")
+			logger.Component("redis").Info("dev mode: redis cache cleared")
 		}
 	}
 
@@ -76,64 +122,346 @@ func main() {
 	// NOT: Bu kontrol Initialize'dan sonra yapılıyor - yukarıdaki cache temizliği
 	// bağlantı başarılı olsa bile çalışmayabilir.
 	if err != nil {
-		log.Fatalf("[REDIS::ERROR] :: Redis connection failed: %v", err)
+		logger.Fatal(logger.Component("redis"), "redis connection failed", "error", err)
+	}
+
+	logger.Component("redis").Info("redis connection successful")
+
+	// -------------------------------------------------------------------------
+	// 3.2 R2 STORAGE - Cloudflare R2 (S3 uyumlu) istemcisi
+	// -------------------------------------------------------------------------
+	r2Client, err := r2.NewR2Client(
+		context.Background(),
+		cfg.R2.AccountID,
+		cfg.R2.AccessKeyID,
+		cfg.R2.AccessKeySecret,
+		cfg.R2.BucketName,
+		cfg.R2.FolderName,
+		cfg.R2.PublicURLBase,
+		cfg.R2.Endpoint,
+	)
+	if err != nil {
+		logger.Fatal(logger.Component("r2"), "failed to create r2 client", "error", err)
+	}
+
+	// -------------------------------------------------------------------------
+	// 3.3 DEPENDENCIES - Repository, Service ve Handler'lar
+	// -------------------------------------------------------------------------
+	validator := validation.New()
+
+	authRepository := auth.NewRepository(db)
+	authService := auth.NewService(authRepository, cfg.Auth, cfg.Cookie)
+	authHandler := auth.NewHandler(authService, validator)
+
+	fileRepository := file.NewRepository(db)
+	fileHandler := file.NewHandler(fileRepository, validator, r2Client)
+
+	accountRepository := account.NewRepository(db)
+	accountService := account.NewService(accountRepository, authService)
+	accountHandler := account.NewHandler(accountService)
+
+	// Kullanıcı verisi tutan her modül export/silme hook'unu burada kaydeder.
+	accountService.Register(
+		file.NewAccountData(fileRepository, r2Client),
+	)
+
+	// Döviz kurları - EXCHANGE_RATE_PROVIDER=static (lokal) veya ecb
+	rateProvider, err := currency.NewProvider(cfg.Currency.Provider, cfg.Currency.RatesBase, cfg.Currency.RatesStatic)
+	if err != nil {
+		logger.Fatal(logger.Component("currency"), "invalid exchange rate provider configuration", "error", err)
+	}
+	currencyRepository := currency.NewRepository(db)
+	currencyService := currency.NewService(currencyRepository, rateProvider)
+	currencyHandler := currency.NewHandler(currencyService)
+
+	defaultCurrency, err := money.ParseCurrency(cfg.Currency.Default)
+	if err != nil {
+		logger.Fatal(mainLog, "invalid DEFAULT_CURRENCY", "error", err)
+	}
+
+	// Bakım / salt-okunur mod - Redis'te tutulur, admin endpoint'lerinden açılıp kapanır
+	maintenanceService := maintenance.NewService()
+	maintenanceHandler := maintenance.NewHandler(maintenanceService, validator)
+
+	// Feature flag'ler - Postgres'te tutulur, pkg/redis ile cache'lenir
+	flagRepository := featureflag.NewRepository(db)
+	flagService := featureflag.NewService(flagRepository)
+	flagHandler := featureflag.NewHandler(flagService, validator)
+
+	// Erişim kuralları - Route grubu (scope) başına IP/CIDR ve ülke allow/deny listeleri
+	accessRepository := accessrule.NewRepository(db)
+	accessService := accessrule.NewService(accessRepository)
+	accessHandler := accessrule.NewHandler(accessService, validator)
+
+	// Tenant CORS origin'leri - CORS_TENANT_ORIGINS=true ise statik listeye ek olarak DB'den okunur
+	corsOriginRepository := corsorigin.NewRepository(db)
+	corsOriginService := corsorigin.NewService(corsOriginRepository)
+	corsOriginHandler := corsorigin.NewHandler(corsOriginService, validator)
+
+	// CSP ihlal raporları - Tarayıcıların report-uri/report-to ile gönderdiği raporlar toplanır
+	cspReportRepository := cspreport.NewRepository(db)
+	cspReportService := cspreport.NewService(cspReportRepository)
+	cspReportHandler := cspreport.NewHandler(cspReportService)
+
+	// Hata raporlama - nil ise panic'ler sadece loglanır. Harici servis bağlamak için:
+	// errorReporter = errreport.Func(func(ctx context.Context, e errreport.Event) { ... })
+	var errorReporter errreport.Reporter
+
+	mw := middleware.NewManager(authService, cfg.Turnstile, cfg.Webhook)
+
+	// Eşzamanlılık sınıfları (pkg/loadshed) - Toplamları DB havuzunu (SetMaxOpenConns 25) aşmaz.
+	// Postgres yavaşlarsa "api" sınıfının limiti gecikmeye göre düşer ve fazlası 503 alır;
+	// "auth" sabit payıyla çalışmaya devam eder, kullanıcılar giriş yapabilir.
+	authLimiter := loadshed.New(loadshed.Config{Name: "auth", Limit: 8, QueueSize: 16, QueueTimeout: 250 * time.Millisecond})
+	apiLimiter := loadshed.New(loadshed.Config{Name: "api", Limit: 15, QueueSize: 30, TargetLatency: 300 * time.Millisecond, MinLimit: 3})
+	exportLimiter := loadshed.New(loadshed.Config{Name: "export", Limit: 2})
+
+	// Client IP resolver - CF-Connecting-IP / X-Forwarded-For sadece güvenilir
+	// proxy'lerden (TRUSTED_PROXIES) ve Cloudflare aralıklarından gelirse dikkate alınır.
+	ipResolver, err := clientip.NewResolver(
+		cfg.Network.TrustedProxies,
+		cfg.Network.TrustCloudflare,
+	)
+	if err != nil {
+		logger.Fatal(mainLog, "invalid trusted proxy configuration", "error", err)
+	}
+
+	// -------------------------------------------------------------------------
+	// 3.4 CRON JOBS - Arka plan işleri
+	// -------------------------------------------------------------------------
+	cronCtx, stopCrons := context.WithCancel(context.Background())
+	defer stopCrons()
+
+	// Cron ilk tick'i beklediği için kurlar açılışta bir kez çekilir.
+	// Başarısız olursa Postgres'teki son kurlarla devam edilir.
+	refreshCtx, cancelRefresh := context.WithTimeout(context.Background(), 15*time.Second)
+	if err := currencyService.Refresh(refreshCtx); err != nil {
+		logger.Component("currency").Warn("initial exchange rate refresh failed", "error", err)
 	}
+	cancelRefresh()
+
+	scheduler := crons.NewScheduler()
+	scheduler.Add(crons.Job{
+		Name:     "account-purge",
+		Interval: time.Hour,
+		Timeout:  10 * time.Minute,
+		Run:      accountService.PurgeDueAccounts,
+	})
+	scheduler.Add(crons.Job{
+		Name:     "cloudflare-ip-refresh",
+		Interval: 24 * time.Hour,
+		Timeout:  time.Minute,
+		Run:      ipResolver.RefreshCloudflare,
+	})
+	scheduler.Add(crons.Job{
+		Name:     "exchange-rate-refresh",
+		Interval: time.Hour,
+		Timeout:  time.Minute,
+		Run:      currencyService.Refresh,
+	})
+	scheduler.Add(crons.Job{
+		Name:     "csp-report-retention",
+		Interval: time.Hour,
+		Timeout:  time.Minute,
+		Run:      cspReportService.PurgeStale,
+	})
+	scheduler.Start(cronCtx)
 
-	log.Println("[REDIS::SUCCESS] :: Redis connection successful.")
+	// Config reload - SIGHUP (kill -HUP <pid>) veya .env değişikliği
+	go cfgStore.Watch(cronCtx, 5*time.Second)
 
 	// -------------------------------------------------------------------------
 	// 4. GIN ROUTER SETUP - HTTP Router konfigürasyonu
 	// -------------------------------------------------------------------------
-	// gin.Default() Logger ve Recovery middleware'lerini otomatik ekler.
-	// - Logger: Her request'i loglar
-	// - Recovery: Panic'leri yakalar ve 500 döner
-	router := gin.Default()
+	// gin.Default() yerine gin.New(): gin'in text logger'ı yerine kendi AccessLog'umuzu kullanıyoruz.
+	// - RequestID: X-Request-Id'yi kabul eder/üretir, context'e ve yanıta yazar (en başta olmalı)
+	// - ClientIP: Gerçek istemci IP'sini çözer (rate limit, log ve session'lar bunu kullanır)
+	// - AccessLog: Her request'i slog ile loglar (route, status, latency, user_id)
+	// - Recovery: Panic'leri yakalar, stack trace'i loglar ve apierror formatında 500 döner
+	// - Locale: X-Language / Accept-Language ile dili çözer (hata ve validasyon mesajları bu dilde döner)
+	// - Currency: X-Currency ile fiyatların döneceği para birimini çözer (TRY, EUR, USD)
+	// - Maintenance: Bakım modunda 503, salt-okunur modda yazma isteklerine 503 (health ve /admin hariç)
+	// - Limits: Varsayılan 1 MB body ve 15 sn handler deadline'ı (route bazında değiştirilebilir)
+	router := gin.New()
+	router.Use(mw.RequestID(), mw.ClientIP(ipResolver), mw.AccessLog(), mw.Recovery(errorReporter))
+	router.Use(mw.Locale(), mw.Currency(defaultCurrency))
 
 	// -------------------------------------------------------------------------
 	// 4.1 MIDDLEWARE CONFIGURATION
 	// -------------------------------------------------------------------------
-	// CORS middleware - Cross-Origin Resource Sharing ayarları
+	// CORS middleware - Cross-Origin Resource Sharing ayarları (CORS_* env değişkenleri)
 	// Frontend'in farklı bir domain'den API'ye erişmesine izin verir.
-	router.Use(configs.CorsConfig())
+	// Override'lar path prefix'e göre seçilir; preflight istekleri de aynı policy'yi görür.
+	router.Use(configs.Dynamic(cfgStore, func(cfg *configs.Config) gin.HandlerFunc {
+		corsPolicy := cfg.CORSPolicy()
+		if cfg.CORS.TenantOrigins {
+			corsPolicy.AllowOrigin = corsOriginService.Allow
+		}
+		return configs.Cors(corsPolicy,
+			// Kur tablosu herkese açık: widget/embed'ler her domain'den, credential'sız okuyabilir
+			configs.CORSOverride{PathPrefix: "/currency", Policy: cfg.PublicCORSPolicy()},
+		)
+	}))
 
-	// Security middleware - Güvenlik header'larını ekler
-	// (X-Content-Type-Options, X-Frame-Options, vb.)
-	router.Use(configs.SecureConfig)
+	// Security middleware - Güvenlik header'larını ekler (SECURITY_* env değişkenleri)
+	// (CSP + istek başına nonce, HSTS, Permissions-Policy, COOP/CORP, X-Frame-Options, vb.)
+	router.Use(configs.Dynamic(cfgStore, func(cfg *configs.Config) gin.HandlerFunc {
+		return configs.Secure(cfg.SecurityPolicy(),
+			configs.SecurityOverride{PathPrefix: "/currency", Policy: cfg.PublicSecurityPolicy()},
+		)
+	}))
 
-	// NOT :: IP takibi yaparken CF-Connecting-IP kullanacağız.
-	// >>> Bu Nginx katmanında çalışan projeler için bir güvenlik katmanı.
-	// X-Forwarded-For header'ı sadece bu IP'lerden geldiğinde güvenilir kabul edilir.
-	// VPS'te Nginx ile sarmalanan bir projede LocalHost değerleri eklememiz gerekiyor.
-	router.SetTrustedProxies([]string{"127.0.0.1", "::1"})
+	// Maintenance ve Limits CORS'tan sonra: bakım modundaki 503'ler (preflight dahil) CORS header'larını
+	// taşımazsa tarayıcı yanıtı CORS hatası sayar ve SPA Retry-After'ı/bakım mesajını okuyamaz.
+	router.Use(mw.Maintenance(maintenanceService, "/", "/admin"), mw.Limits(middleware.DefaultRouteLimits))
+
+	// NOT :: IP çözümleme mw.ClientIP (pkg/clientip) tarafından yapılıyor.
+	// CF-Connecting-IP ve X-Forwarded-For sadece TRUSTED_PROXIES ve Cloudflare
+	// aralıklarından geldiğinde güvenilir kabul edilir. Gin'in kendi çözümlemesini
+	// kapatıyoruz ki yanlışlıkla c.ClientIP() kullanılırsa sahte header'a kanmasın.
+	router.SetTrustedProxies(nil)
 
 	// -------------------------------------------------------------------------
 	// 5. ROUTES - API endpoint tanımlamaları
 	// -------------------------------------------------------------------------
 	// Health check / root endpoint
 	// API'nin çalışır durumda olduğunu doğrulamak için kullanılır.
+	// Dış bağımlılıkların breaker durumu da döner. Açık breaker instance'ı load balancer'dan
+	// çıkarmamalı (tüm instance'lar aynı bağımlılığı kullanır), bu yüzden status 200 kalır.
 	router.GET("/", func(c *gin.Context) {
+		status := "ok"
+		if !resilience.Healthy() {
+			status = "degraded"
+		}
 		c.JSON(200, gin.H{
-			"message": "Go Template API is running!",
-			"env":     os.Getenv("MAIN_CONN_STRING"),
+			"message":      "Go Template API is running!",
+			"status":       status,
+			"dependencies": resilience.Snapshot(),
 		})
 	})
 
+	// CSP raporları - Tarayıcı gönderir, auth yok. IP başına limit ve küçük body ile flood sınırlanır.
+	router.POST("/csp-report",
+		configs.Dynamic(cfgStore, func(cfg *configs.Config) gin.HandlerFunc {
+			return mw.RateLimit(middleware.RateLimitPolicy{Name: "csp-report", Limit: cfg.RateLimit.CSPReport, Period: time.Minute, KeyBy: middleware.KeyByIP})
+		}),
+		mw.Limits(middleware.RouteLimits{MaxBodyBytes: 64 << 10}),
+		cspReportHandler.Ingest,
+	)
+
+	// Auth - Oturum işlemleri
+	// Brute-force'a karşı IP başına sıkı limit.
+	authGroup := router.Group("/auth",
+		configs.Dynamic(cfgStore, func(cfg *configs.Config) gin.HandlerFunc {
+			return mw.RateLimit(middleware.RateLimitPolicy{Name: "auth", Limit: cfg.RateLimit.Auth, Period: time.Minute, KeyBy: middleware.KeyByIP})
+		}),
+		mw.LoadShed(authLimiter),
+		mw.AuthMiddleware(),
+	)
+	{
+		authGroup.POST("/reauthenticate", authHandler.Reauthenticate)
+		authGroup.PUT("/locale", authHandler.UpdateLocale)
+	}
+
+	// Files - R2 presigned upload URL'leri
+	// Mobil client'lar ağ kopunca POST'u tekrarlar; Idempotency-Key ile ikinci bir URL/kayıt üretilmez.
+	fileGroup := router.Group("/files", mw.LoadShed(apiLimiter), mw.AuthMiddleware(), mw.Idempotency())
+	{
+		fileGroup.POST("/presigned-url", fileHandler.CreatePresignedURL)
+	}
+
+	// Currency - Güncel kurlar (herkese açık, kurlar yenilenince cache temizlenir)
+	router.GET("/currency/rates", mw.LoadShed(apiLimiter), mw.ResponseCache(middleware.ResponseCacheOptions{
+		Store:  true,
+		Public: true,
+		TTL:    time.Hour,
+		MaxAge: 5 * time.Minute,
+		Dependencies: func(c *gin.Context) []redis.Dependency {
+			return []redis.Dependency{currency.CacheDependency}
+		},
+	}), currencyHandler.ListRates)
+
+	// Webhooks - Partner webhook'ları (ödeme, storage). İmza mw.Webhook ile doğrulanır,
+	// secret'lar WEBHOOK_<SOURCE>_SECRETS env değerinden okunur. Örnek:
+	// webhookGroup := router.Group("/webhooks", mw.LoadShed(apiLimiter))
+	// webhookGroup.POST("/stripe", mw.WebhookFromConfig("stripe", webhook.Stripe{}), paymentHandler.StripeWebhook)
+	// webhookGroup.POST("/storage", mw.WebhookFromConfig("storage", webhook.GitHub{Header: "X-Signature"}), fileHandler.StorageWebhook)
+
+	// Account - GDPR veri export'u ve hesap silme
+	// Hesap silme gibi hassas işlemler yakın zamanda şifre onayı gerektirir (RequireRecentAuth).
+	accountGroup := router.Group("/account",
+		mw.LoadShed(apiLimiter),
+		mw.AuthMiddleware(),
+		configs.Dynamic(cfgStore, func(cfg *configs.Config) gin.HandlerFunc {
+			return mw.RateLimit(middleware.RateLimitPolicy{Name: "account", Limit: cfg.RateLimit.Account, Period: time.Minute, KeyBy: middleware.KeyByUser})
+		}),
+	)
+	{
+		// Export tüm modüllerden veri topladığı için varsayılan 15 sn yetmeyebilir.
+		// Uzun süren export'lar ayrıca kendi sınıfıyla sınırlanır (aynı anda en fazla 2).
+		accountGroup.GET("/export", mw.Limits(middleware.RouteLimits{Timeout: time.Minute}), mw.LoadShed(exportLimiter), accountHandler.ExportData)
+		accountGroup.DELETE("", mw.RequireRecentAuth(), accountHandler.RequestDeletion)
+		accountGroup.POST("/deletion/cancel", accountHandler.CancelDeletion)
+	}
+
+	// Admin - Sadece "admin" rolü. Bakım modunda da erişilebilir (mw.Maintenance exempt).
+	// AccessRules auth'tan önce çalışır: izin verilmeyen ağlardan gelen istekler token kontrolüne bile ulaşmaz.
+	adminGroup := router.Group("/admin", mw.AccessRules(accessService, "admin"), mw.LoadShed(apiLimiter), mw.AuthMiddleware(), mw.RequireRole("admin"))
+	{
+		adminGroup.GET("/maintenance", maintenanceHandler.GetState)
+		adminGroup.PUT("/maintenance", maintenanceHandler.UpdateState)
+
+		// Yeni bir route'u flag arkasına almak: group.GET("/x", mw.RequireFeature(flagService, "x"), handler)
+		adminGroup.GET("/flags", flagHandler.ListFlags)
+		adminGroup.POST("/flags", flagHandler.SaveFlag)
+		adminGroup.GET("/flags/:key", flagHandler.GetFlag)
+		adminGroup.PUT("/flags/:key", flagHandler.SaveFlag)
+		adminGroup.DELETE("/flags/:key", flagHandler.DeleteFlag)
+
+		// Başka bir grubu korumak: router.Group("/x", mw.AccessRules(accessService, "x"))
+		adminGroup.GET("/access-rules", accessHandler.ListRules)
+		adminGroup.POST("/access-rules", accessHandler.CreateRule)
+		adminGroup.DELETE("/access-rules/:id", accessHandler.DeleteRule)
+
+		adminGroup.GET("/cors-origins", corsOriginHandler.ListOrigins)
+		adminGroup.POST("/cors-origins", corsOriginHandler.CreateOrigin)
+		adminGroup.DELETE("/cors-origins/:id", corsOriginHandler.DeleteOrigin)
+
+		adminGroup.GET("/csp-reports", cspReportHandler.ListReports)
+		adminGroup.DELETE("/csp-reports/:id", cspReportHandler.DeleteReport)
+
+		// Dış bağımlılık (pkg/resilience) ve eşzamanlılık sınıfı (pkg/loadshed) metrikleri
+		adminGroup.GET("/dependencies", func(c *gin.Context) {
+			c.JSON(200, gin.H{
+				"success": true,
+				"data": gin.H{
+					"dependencies": resilience.Snapshot(),
+					"concurrency":  []loadshed.Stats{authLimiter.Stats(), apiLimiter.Stats(), exportLimiter.Stats()},
+				},
+			})
+		})
+	}
+
 	// -------------------------------------------------------------------------
 	// 6. SERVER START - HTTP sunucusunu başlat
 	// -------------------------------------------------------------------------
-	// Port belirleme - önce environment variable, yoksa default 8080
-	port := os.Getenv("PORT")
-	if port == "" {
-		port = "8080"
-		log.Println("[MAIN::INFO] :: PORT environment variable not set, using default 8080.")
-	}
+	// Server adresi formatlanır (örn: ":8080"). PORT boşsa config default'u 8080.
+	serverAddr := fmt.Sprintf(":%s", cfg.Server.Port)
 
-	// Server adresi formatlanır (örn: ":8080")
-	serverAddr := fmt.Sprintf(":%s", port)
+	// router.Run yerine http.Server: header'ları yavaş gönderen (slowloris) veya
+	// boşta bekleyen bağlantılar sınırsız süre açık kalmasın.
+	// Body okuma ve handler süresi route bazında mw.Limits ile sınırlanır.
+	server := &http.Server{
+		Addr:              serverAddr,
+		Handler:           router,
+		ReadHeaderTimeout: 10 * time.Second,
+		IdleTimeout:       2 * time.Minute,
+	}
 
 	// Sunucu başlatılır - bu satır blocking'dir.
 	// Hata durumunda uygulama tamamen durur.
-	if err := router.Run(serverAddr); err != nil {
-		log.Fatalf("[MAIN::ERROR] :: Failed to start server: %v", err)
+	mainLog.Info("server starting", "addr", serverAddr)
+	if err := server.ListenAndServe(); err != nil {
+		logger.Fatal(mainLog, "failed to start server", "error", err)
 	}
 }
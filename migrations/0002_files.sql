@@ -0,0 +1,15 @@
+-- R2'ye yüklenen dosyaların metadata kayıtları
+
+CREATE TABLE IF NOT EXISTS files (
+    id            UUID PRIMARY KEY,
+    user_id       UUID NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    object_key    TEXT NOT NULL UNIQUE,
+    url           TEXT NOT NULL,
+    filename      TEXT NOT NULL,
+    content_type  TEXT NOT NULL,
+    size_in_bytes BIGINT NOT NULL,
+    category      TEXT NOT NULL DEFAULT 'general',
+    created_at    TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_files_user_id ON files (user_id);
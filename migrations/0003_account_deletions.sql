@@ -0,0 +1,12 @@
+-- Hesap silme talepleri (GDPR right-to-erasure)
+-- users tablosuna FK yok: kullanıcı silindikten sonra da talep kaydı audit için kalır.
+
+CREATE TABLE IF NOT EXISTS account_deletions (
+    user_id       UUID PRIMARY KEY,
+    status        TEXT NOT NULL DEFAULT 'pending', -- pending | purging | purged
+    requested_at  TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    scheduled_for TIMESTAMPTZ NOT NULL,
+    purged_at     TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS idx_account_deletions_due ON account_deletions (scheduled_for) WHERE status = 'pending';
@@ -0,0 +1,4 @@
+-- Kullanıcının tercih ettiği dil (X-Language gönderilmediğinde kullanılır)
+-- Boş değer "tercih yok" demektir; Accept-Language'a düşülür.
+
+ALTER TABLE users ADD COLUMN IF NOT EXISTS locale TEXT NOT NULL DEFAULT '';
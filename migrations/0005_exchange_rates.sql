@@ -0,0 +1,12 @@
+-- Döviz kurları (internal/currency)
+-- Her satır: 1 birim base = rate birim quote. Sadece en güncel kur tutulur;
+-- cron her çalıştığında satırları günceller.
+
+CREATE TABLE IF NOT EXISTS exchange_rates (
+    base       TEXT NOT NULL,
+    quote      TEXT NOT NULL,
+    rate       NUMERIC(24, 12) NOT NULL,
+    provider   TEXT NOT NULL,
+    fetched_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (base, quote)
+);
@@ -0,0 +1,14 @@
+-- Feature flag'ler (internal/featureflag)
+-- Değerlendirme sırası: enabled (kill switch) -> allow_users -> roles/tenants -> rollout_percentage
+
+CREATE TABLE IF NOT EXISTS feature_flags (
+    key                TEXT PRIMARY KEY,
+    description        TEXT NOT NULL DEFAULT '',
+    enabled            BOOLEAN NOT NULL DEFAULT FALSE,
+    rollout_percentage INTEGER NOT NULL DEFAULT 100 CHECK (rollout_percentage BETWEEN 0 AND 100),
+    roles              TEXT[] NOT NULL DEFAULT '{}',
+    tenants            TEXT[] NOT NULL DEFAULT '{}',
+    allow_users        UUID[] NOT NULL DEFAULT '{}',
+    created_at         TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at         TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
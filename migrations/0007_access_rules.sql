@@ -0,0 +1,17 @@
+-- IP / ülke erişim kuralları (internal/accessrule)
+-- scope: kuralın uygulandığı route grubu ("admin", "global" vb.)
+-- Bir scope'ta ilgili türde allow kuralı varsa istek bunlardan birine uymalıdır; deny her zaman önceliklidir.
+
+CREATE TABLE IF NOT EXISTS access_rules (
+    id         UUID PRIMARY KEY,
+    scope      TEXT NOT NULL,
+    kind       TEXT NOT NULL CHECK (kind IN ('cidr', 'country')),
+    action     TEXT NOT NULL CHECK (action IN ('allow', 'deny')),
+    value      TEXT NOT NULL,
+    note       TEXT NOT NULL DEFAULT '',
+    created_by UUID,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    UNIQUE (scope, kind, action, value)
+);
+
+CREATE INDEX IF NOT EXISTS idx_access_rules_scope ON access_rules (scope);
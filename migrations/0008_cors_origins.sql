@@ -0,0 +1,12 @@
+-- Tenant'lara özel CORS origin'leri (internal/corsorigin)
+-- CORS_TENANT_ORIGINS=true ise CORS_ALLOWED_ORIGINS'te olmayan origin'ler bu tablodan kontrol edilir.
+-- origin: tam değer ("https://app.customer.com") veya subdomain pattern'i ("https://*.customer.com")
+
+CREATE TABLE IF NOT EXISTS cors_origins (
+    id         UUID PRIMARY KEY,
+    origin     TEXT NOT NULL UNIQUE,
+    tenant_id  TEXT NOT NULL DEFAULT '',
+    note       TEXT NOT NULL DEFAULT '',
+    created_by UUID,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
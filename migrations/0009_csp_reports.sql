@@ -0,0 +1,22 @@
+-- CSP ihlal ve Reporting API raporları (internal/cspreport)
+-- Aynı ihlal (fingerprint) tekrar geldiğinde yeni satır eklenmez, count ve last_seen güncellenir.
+-- URL'ler query string'siz saklanır (token/PII sızmasın).
+
+CREATE TABLE IF NOT EXISTS csp_reports (
+    id           UUID PRIMARY KEY,
+    fingerprint  TEXT NOT NULL UNIQUE,
+    type         TEXT NOT NULL,
+    document_url TEXT NOT NULL DEFAULT '',
+    blocked_url  TEXT NOT NULL DEFAULT '',
+    directive    TEXT NOT NULL DEFAULT '',
+    disposition  TEXT NOT NULL DEFAULT '',
+    source_file  TEXT NOT NULL DEFAULT '',
+    line_number  INT NOT NULL DEFAULT 0,
+    sample       TEXT NOT NULL DEFAULT '',
+    user_agent   TEXT NOT NULL DEFAULT '',
+    count        BIGINT NOT NULL DEFAULT 1,
+    first_seen   TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    last_seen    TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_csp_reports_last_seen ON csp_reports (last_seen DESC);
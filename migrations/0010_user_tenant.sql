@@ -0,0 +1,4 @@
+-- Kullanıcının bağlı olduğu tenant. Boş = tenant'sız.
+-- Access token / opaque session'a taşınır; feature flag tenant hedeflemesi bunu kullanır.
+
+ALTER TABLE users ADD COLUMN IF NOT EXISTS tenant_id TEXT NOT NULL DEFAULT '';
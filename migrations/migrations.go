@@ -0,0 +1,9 @@
+package migrations
+
+import "embed"
+
+// FS, cmd/migrate tarafından dosya adı sırasına göre uygulanan SQL dosyalarını içerir.
+// Yeni bir migration eklerken numarayı artırın: 0004_xxx.sql
+//
+//go:embed *.sql
+var FS embed.FS
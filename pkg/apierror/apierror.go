@@ -1,19 +1,24 @@
 package apierror
 
 import (
+	"context"
+	"errors"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
+	"github.com/okanay/go-template/pkg/i18n"
+	"github.com/okanay/go-template/pkg/requestid"
 )
 
 type ErrorKey string
 type ErrorMessage string
 
 type AppError struct {
-	Status  int          `json:"-"`
-	Key     ErrorKey     `json:"errorKey"`
-	Message ErrorMessage `json:"message"`
-	Details any          `json:"details,omitempty"`
+	Status    int          `json:"-"`
+	Key       ErrorKey     `json:"errorKey"`
+	Message   ErrorMessage `json:"message"`
+	Details   any          `json:"details,omitempty"`
+	RequestID string       `json:"requestId,omitempty"`
 }
 
 const (
@@ -26,6 +31,29 @@ const (
 	ErrBadRequest   ErrorKey = "Bad request"
 	ErrConflict     ErrorKey = "Conflict"
 
+	ErrReauthRequired     ErrorKey = "Reauthentication required"
+	ErrInvalidCredentials ErrorKey = "Invalid credentials"
+	ErrTooManyRequests    ErrorKey = "Too many requests"
+	ErrCaptchaFailed      ErrorKey = "Captcha failed"
+	ErrCaptchaUnavailable ErrorKey = "Captcha unavailable"
+
+	ErrIdempotencyInProgress ErrorKey = "Idempotency key in progress"
+	ErrIdempotencyMismatch   ErrorKey = "Idempotency key mismatch"
+
+	ErrPayloadTooLarge ErrorKey = "Payload too large"
+	ErrTimeout         ErrorKey = "Timeout"
+
+	ErrRatesUnavailable   ErrorKey = "Exchange rates unavailable"
+	ErrServiceUnavailable ErrorKey = "Service unavailable"
+
+	ErrMaintenance ErrorKey = "Maintenance"
+	ErrReadOnly    ErrorKey = "Read only"
+
+	ErrAccessDenied ErrorKey = "Access denied"
+
+	ErrInvalidSignature  ErrorKey = "Invalid signature"
+	ErrWebhookInProgress ErrorKey = "Webhook in progress"
+
 	// Error Messages
 	MsgValidation   ErrorMessage = "Validation failed. Please check your input."
 	MsgInternal     ErrorMessage = "Internal server error. Please try again later."
@@ -34,21 +62,73 @@ const (
 	MsgForbidden    ErrorMessage = "Forbidden. You don't have permission."
 	MsgBadRequest   ErrorMessage = "Bad request. Invalid parameters."
 	MsgConflict     ErrorMessage = "Conflict. Resource already exists."
+
+	MsgReauthRequired     ErrorMessage = "Please confirm your password to continue."
+	MsgInvalidCredentials ErrorMessage = "Invalid credentials."
+	MsgTooManyRequests    ErrorMessage = "Too many requests. Please slow down."
+	MsgCaptchaFailed      ErrorMessage = "Bot verification failed. Please try again."
+	MsgCaptchaUnavailable ErrorMessage = "Bot verification is temporarily unavailable."
+
+	MsgIdempotencyInProgress ErrorMessage = "A request with this Idempotency-Key is still being processed."
+	MsgIdempotencyMismatch   ErrorMessage = "This Idempotency-Key was already used with a different request."
+
+	MsgPayloadTooLarge ErrorMessage = "Request body is too large."
+	MsgTimeout         ErrorMessage = "The request took too long to process. Please try again."
+
+	MsgRatesUnavailable   ErrorMessage = "Exchange rates are temporarily unavailable."
+	MsgServiceUnavailable ErrorMessage = "Service is temporarily unavailable. Please try again later."
+
+	MsgMaintenance ErrorMessage = "We are performing scheduled maintenance. Please try again shortly."
+	MsgReadOnly    ErrorMessage = "Changes are temporarily disabled for maintenance. Please try again shortly."
+
+	MsgAccessDenied ErrorMessage = "Access from your network or location is not allowed."
+
+	MsgInvalidSignature  ErrorMessage = "Webhook signature verification failed."
+	MsgWebhookInProgress ErrorMessage = "This webhook delivery is still being processed."
 )
 
+// BodyTooLargeKey - Request body limiti aşıldığında middleware.Limits tarafından context'e yazılır.
+const BodyTooLargeKey = "bodyTooLarge"
+
 func Error(c *gin.Context, status int, key ErrorKey, msg ErrorMessage) {
+	ErrorWithDetails(c, status, key, msg, nil)
+}
+
+// ErrorWithDetails - Error ile aynı, client'ın göstermesi gereken ek bilgiyi (örn: bakım mesajı) Details'e koyar.
+func ErrorWithDetails(c *gin.Context, status int, key ErrorKey, msg ErrorMessage, details any) {
+	// Handler'ın deadline'ı (middleware.Limits) dolduğu için DB/R2/Redis çağrısı
+	// başarısız olduysa, handler hangi 5xx'i seçerse seçsin yanıt tutarlı şekilde 504 olur.
+	if status >= http.StatusInternalServerError && errors.Is(c.Request.Context().Err(), context.DeadlineExceeded) {
+		status, key, msg, details = http.StatusGatewayTimeout, ErrTimeout, MsgTimeout, nil
+	}
+
 	c.AbortWithStatusJSON(status, AppError{
-		Status:  status,
-		Key:     key,
-		Message: msg,
+		Status:    status,
+		Key:       key,
+		Message:   translate(c, msg),
+		Details:   details,
+		RequestID: requestid.FromContext(c.Request.Context()),
 	})
 }
 
 func ValidationError(c *gin.Context, violations any) {
+	// Binding hatası body limitinden kaynaklanıyorsa (middleware.Limits) 413 döner
+	if c.GetBool(BodyTooLargeKey) {
+		Error(c, http.StatusRequestEntityTooLarge, ErrPayloadTooLarge, MsgPayloadTooLarge)
+		return
+	}
+
 	c.AbortWithStatusJSON(http.StatusBadRequest, AppError{
-		Status:  http.StatusBadRequest,
-		Key:     ErrValidation,
-		Message: MsgValidation,
-		Details: violations,
+		Status:    http.StatusBadRequest,
+		Key:       ErrValidation,
+		Message:   translate(c, MsgValidation),
+		Details:   violations,
+		RequestID: requestid.FromContext(c.Request.Context()),
 	})
 }
+
+// translate - Mesajı middleware.Locale'in çözümlediği dile çevirir.
+// Katalogda karşılığı olmayan mesajlar İngilizce döner.
+func translate(c *gin.Context, msg ErrorMessage) ErrorMessage {
+	return ErrorMessage(i18n.T(i18n.FromContext(c.Request.Context()), string(msg)))
+}
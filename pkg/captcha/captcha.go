@@ -0,0 +1,36 @@
+package captcha
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+)
+
+var ErrMissingSecret = errors.New("captcha secret key is not set")
+
+// HTTPClient - Test ve lokal geliştirmede siteverify yerine stand-in kullanabilmek için.
+// *http.Client bu arayüzü zaten uygular.
+type HTTPClient interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// Provider, bir captcha servisinin token doğrulama arayüzü.
+// Turnstile, hCaptcha ve reCAPTCHA aynı siteverify sözleşmesini kullanır;
+// farklı bir servis eklemek için bu arayüzü uygulamak yeterli.
+type Provider interface {
+	// Name - Log ve cache key'lerinde kullanılır: "turnstile", "hcaptcha", "recaptcha"
+	Name() string
+	// FormField - Widget'ın form'a eklediği alan adı (örn: cf-turnstile-response)
+	FormField() string
+	Verify(ctx context.Context, token string, remoteIP string) (*Result, error)
+}
+
+// Result, siteverify yanıtının provider'dan bağımsız hali.
+type Result struct {
+	Success     bool      `json:"success"`
+	Hostname    string    `json:"hostname"`
+	Action      string    `json:"action"`
+	ChallengeTS time.Time `json:"challengeTs"`
+	ErrorCodes  []string  `json:"errorCodes,omitempty"`
+}
@@ -0,0 +1,176 @@
+package captcha
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/goccy/go-json"
+	"github.com/okanay/go-template/pkg/resilience"
+)
+
+const (
+	TurnstileVerifyURL = "https://challenges.cloudflare.com/turnstile/v0/siteverify"
+	HCaptchaVerifyURL  = "https://api.hcaptcha.com/siteverify"
+	ReCaptchaVerifyURL = "https://www.google.com/recaptcha/api/siteverify"
+)
+
+// siteverify - secret/response/remoteip form POST'u alan tüm provider'ların ortak implementasyonu.
+type siteverify struct {
+	name      string
+	formField string
+	verifyURL string
+	secret    string
+	client    HTTPClient
+	dep       *resilience.Dependency
+}
+
+// statusError - siteverify'ın 2xx dışı yanıtı. 5xx ve 429 breaker'a bağımlılık hatası olarak yazılır.
+type statusError struct {
+	name string
+	code int
+}
+
+func (e *statusError) Error() string {
+	return fmt.Sprintf("[CAPTCHA] :: %s siteverify returned status %d", e.name, e.code)
+}
+
+// dependencies - Provider adı başına tek Dependency. Middleware her route için ayrı provider
+// oluşturur; breaker ve bulkhead'in tüm route'larda ortak olması için burada paylaşılır.
+var (
+	dependenciesMu sync.Mutex
+	dependencies   = map[string]*resilience.Dependency{}
+)
+
+// dependency - İlk çağrıda oluşturur, sonraki çağrılarda aynı Dependency'yi döner.
+// Token'lar tek kullanımlık: Verify DoOnce ile çağrılır, sadece istek hiç gitmediyse tekrarlanır.
+func dependency(name string) *resilience.Dependency {
+	dependenciesMu.Lock()
+	defer dependenciesMu.Unlock()
+
+	if dep, ok := dependencies[name]; ok {
+		return dep
+	}
+	dep := resilience.New(resilience.Config{
+		Name:          name,
+		Retry:         resilience.RetryPolicy{MaxAttempts: 2, Retryable: isRetryable},
+		MaxConcurrent: 32,
+		MaxQueued:     64,
+		MaxWait:       time.Second,
+	})
+	dependencies[name] = dep
+	return dep
+}
+
+func isRetryable(err error) bool {
+	var se *statusError
+	if errors.As(err, &se) {
+		return se.code >= 500 || se.code == http.StatusTooManyRequests
+	}
+	return resilience.IsTransient(err)
+}
+
+// NewTurnstile - Cloudflare Turnstile. client nil ise 10 sn timeout'lu http.Client kullanılır.
+func NewTurnstile(secret string, client HTTPClient) Provider {
+	return newSiteverify("turnstile", "cf-turnstile-response", TurnstileVerifyURL, secret, client)
+}
+
+func NewHCaptcha(secret string, client HTTPClient) Provider {
+	return newSiteverify("hcaptcha", "h-captcha-response", HCaptchaVerifyURL, secret, client)
+}
+
+func NewReCaptcha(secret string, client HTTPClient) Provider {
+	return newSiteverify("recaptcha", "g-recaptcha-response", ReCaptchaVerifyURL, secret, client)
+}
+
+// WithVerifyURL - Lokal stand-in sunucusuna yönlendirmek için verify URL'ini değiştirir.
+func WithVerifyURL(p Provider, verifyURL string) Provider {
+	if sv, ok := p.(*siteverify); ok {
+		clone := *sv
+		clone.verifyURL = verifyURL
+		return &clone
+	}
+	return p
+}
+
+func newSiteverify(name, formField, verifyURL, secret string, client HTTPClient) *siteverify {
+	if client == nil {
+		client = &http.Client{Timeout: 10 * time.Second}
+	}
+	return &siteverify{
+		name:      name,
+		formField: formField,
+		verifyURL: verifyURL,
+		secret:    secret,
+		client:    client,
+		dep:       dependency(name),
+	}
+}
+
+func (s *siteverify) Name() string {
+	return s.name
+}
+
+func (s *siteverify) FormField() string {
+	return s.formField
+}
+
+func (s *siteverify) Verify(ctx context.Context, token string, remoteIP string) (*Result, error) {
+	if s.secret == "" {
+		return nil, ErrMissingSecret
+	}
+
+	form := url.Values{}
+	form.Set("secret", s.secret)
+	form.Set("response", token)
+	if remoteIP != "" {
+		form.Set("remoteip", remoteIP)
+	}
+
+	var body struct {
+		Success     bool     `json:"success"`
+		Hostname    string   `json:"hostname"`
+		Action      string   `json:"action"`
+		ChallengeTS string   `json:"challenge_ts"`
+		ErrorCodes  []string `json:"error-codes"`
+	}
+	err := s.dep.DoOnce(ctx, func(ctx context.Context) error {
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.verifyURL, strings.NewReader(form.Encode()))
+		if err != nil {
+			return resilience.Permanent(err)
+		}
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+		resp, err := s.client.Do(req)
+		if err != nil {
+			return fmt.Errorf("[CAPTCHA] :: %s siteverify request failed: %w", s.name, err)
+		}
+		defer resp.Body.Close()
+
+		if resp.StatusCode != http.StatusOK {
+			return &statusError{name: s.name, code: resp.StatusCode}
+		}
+		if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+			return fmt.Errorf("[CAPTCHA] :: %s siteverify decode failed: %w", s.name, err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	challengeTS, _ := time.Parse(time.RFC3339, body.ChallengeTS)
+
+	return &Result{
+		Success:     body.Success,
+		Hostname:    body.Hostname,
+		Action:      body.Action,
+		ChallengeTS: challengeTS,
+		ErrorCodes:  body.ErrorCodes,
+	}, nil
+}
@@ -0,0 +1,260 @@
+package clientip
+
+import (
+	"bufio"
+	"context"
+	_ "embed"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/netip"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	HeaderCFConnectingIP = "CF-Connecting-IP"
+	HeaderCFIPCountry    = "CF-IPCountry"
+	HeaderXForwardedFor  = "X-Forwarded-For"
+
+	CloudflareIPv4URL = "https://www.cloudflare.com/ips-v4"
+	CloudflareIPv6URL = "https://www.cloudflare.com/ips-v6"
+)
+
+//go:embed cloudflare_ips.txt
+var bundledCloudflareRanges string
+
+// Resolver, isteğin gerçek istemci IP'sini bulur.
+//
+// Header'lar sadece güvenilen bir hop'tan geldiğinde dikkate alınır:
+//  1. RemoteAddr güvenilir proxy (TRUSTED_PROXIES) veya Cloudflare değilse direkt o kullanılır.
+//  2. Zincir sağdan sola (en yakın hop'tan başlayarak) X-Forwarded-For üzerinden yürünür.
+//  3. Yürürken bir Cloudflare hop'una rastlanırsa CF-Connecting-IP kullanılır.
+//  4. Güvenilir olmayan ilk hop istemci IP'sidir.
+//
+// Böylece Cloudflare -> Nginx -> App ve direkt Nginx -> App kurulumları aynı kodla çalışır,
+// dışarıdan gönderilen sahte header'lar ise yok sayılır.
+type Resolver struct {
+	trusted    []netip.Prefix
+	cloudflare atomic.Pointer[[]netip.Prefix]
+	useCF      bool
+}
+
+// NewResolver - trustedProxies: "127.0.0.1", "10.0.0.0/8" gibi IP veya CIDR'lar.
+// useCloudflare true ise gömülü Cloudflare listesi yüklenir.
+func NewResolver(trustedProxies []string, useCloudflare bool) (*Resolver, error) {
+	r := &Resolver{useCF: useCloudflare}
+
+	for _, p := range trustedProxies {
+		prefix, err := parsePrefix(p)
+		if err != nil {
+			return nil, fmt.Errorf("invalid trusted proxy %q: %w", p, err)
+		}
+		r.trusted = append(r.trusted, prefix)
+	}
+
+	cf, err := parseRangeList(strings.NewReader(bundledCloudflareRanges))
+	if err != nil {
+		return nil, err
+	}
+	r.cloudflare.Store(&cf)
+
+	return r, nil
+}
+
+// Resolve - İstemci IP'sini döner. Parse edilemeyen durumlarda RemoteAddr'a düşer.
+func (r *Resolver) Resolve(req *http.Request) string {
+	ip, _ := r.resolve(req)
+	return ip
+}
+
+// Country - CF-IPCountry'deki ISO ülke kodu ("TR", "DE"; bilinmiyorsa "XX", Tor için "T1").
+// Header sadece istek zincirde gerçekten bir Cloudflare hop'undan geçtiyse dikkate alınır;
+// aksi halde "" döner, çünkü client header'ı kendisi gönderebilir.
+func (r *Resolver) Country(req *http.Request) string {
+	if _, viaCloudflare := r.resolve(req); !viaCloudflare {
+		return ""
+	}
+	return strings.ToUpper(strings.TrimSpace(req.Header.Get(HeaderCFIPCountry)))
+}
+
+// resolve - IP'yi ve IP'nin bir Cloudflare hop'u üzerinden çözülüp çözülmediğini döner.
+func (r *Resolver) resolve(req *http.Request) (string, bool) {
+	remote := remoteIP(req)
+	if !remote.IsValid() {
+		return req.RemoteAddr, false
+	}
+
+	// hops: en yakından en uzağa (RemoteAddr, sonra XFF sağdan sola)
+	hops := []netip.Addr{remote}
+	xff := strings.Split(strings.Join(req.Header.Values(HeaderXForwardedFor), ","), ",")
+	for i := len(xff) - 1; i >= 0; i-- {
+		if addr, err := netip.ParseAddr(strings.TrimSpace(xff[i])); err == nil {
+			hops = append(hops, addr.Unmap())
+		} else if strings.TrimSpace(xff[i]) != "" {
+			break // Bozuk entry'den sonrasına güvenmiyoruz
+		}
+	}
+
+	for i, hop := range hops {
+		if r.isCloudflare(hop) {
+			if cf, err := netip.ParseAddr(strings.TrimSpace(req.Header.Get(HeaderCFConnectingIP))); err == nil {
+				return cf.Unmap().String(), true
+			}
+			continue
+		}
+
+		if r.isTrusted(hop) && i < len(hops)-1 {
+			continue
+		}
+
+		return hop.String(), false
+	}
+
+	return remote.String(), false
+}
+
+// RefreshCloudflare - Güncel Cloudflare aralıklarını indirir ve atomik olarak değiştirir.
+// Hata durumunda mevcut liste korunur. Cron ile günlük çalıştırılır.
+func (r *Resolver) RefreshCloudflare(ctx context.Context) error {
+	if !r.useCF {
+		return nil
+	}
+
+	client := &http.Client{Timeout: 15 * time.Second}
+	var ranges []netip.Prefix
+
+	for _, url := range []string{CloudflareIPv4URL, CloudflareIPv6URL} {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+		if err != nil {
+			return err
+		}
+		resp, err := client.Do(req)
+		if err != nil {
+			return fmt.Errorf("cloudflare ranges fetch failed: %w", err)
+		}
+		if resp.StatusCode != http.StatusOK {
+			resp.Body.Close()
+			return fmt.Errorf("cloudflare ranges fetch returned status %d", resp.StatusCode)
+		}
+		list, err := parseRangeList(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			return err
+		}
+		ranges = append(ranges, list...)
+	}
+
+	if len(ranges) == 0 {
+		return fmt.Errorf("cloudflare ranges response is empty")
+	}
+
+	r.cloudflare.Store(&ranges)
+	return nil
+}
+
+func (r *Resolver) isCloudflare(addr netip.Addr) bool {
+	if !r.useCF {
+		return false
+	}
+	return containsAddr(*r.cloudflare.Load(), addr)
+}
+
+func (r *Resolver) isTrusted(addr netip.Addr) bool {
+	return containsAddr(r.trusted, addr)
+}
+
+// ═══════════════════════════════════════════════════════════════════
+// CONTEXT HELPERS
+// ═══════════════════════════════════════════════════════════════════
+
+type contextKey struct{}
+type countryContextKey struct{}
+
+func NewContext(ctx context.Context, ip string) context.Context {
+	return context.WithValue(ctx, contextKey{}, ip)
+}
+
+func FromContext(ctx context.Context) string {
+	ip, _ := ctx.Value(contextKey{}).(string)
+	return ip
+}
+
+func NewCountryContext(ctx context.Context, country string) context.Context {
+	return context.WithValue(ctx, countryContextKey{}, country)
+}
+
+// CountryFromContext - Resolver.Country'nin sonucu; Cloudflare arkasında değilsek "".
+func CountryFromContext(ctx context.Context) string {
+	country, _ := ctx.Value(countryContextKey{}).(string)
+	return country
+}
+
+// Get - Resolver middleware'inin çözdüğü IP'yi döner. Middleware çalışmadıysa
+// (örn: testlerde) gin'in ClientIP'sine düşer.
+func Get(c *gin.Context) string {
+	if ip := FromContext(c.Request.Context()); ip != "" {
+		return ip
+	}
+	return c.ClientIP()
+}
+
+// ═══════════════════════════════════════════════════════════════════
+// PARSING
+// ═══════════════════════════════════════════════════════════════════
+
+func remoteIP(req *http.Request) netip.Addr {
+	host, _, err := net.SplitHostPort(strings.TrimSpace(req.RemoteAddr))
+	if err != nil {
+		host = req.RemoteAddr
+	}
+	addr, err := netip.ParseAddr(host)
+	if err != nil {
+		return netip.Addr{}
+	}
+	return addr.Unmap()
+}
+
+func parsePrefix(s string) (netip.Prefix, error) {
+	s = strings.TrimSpace(s)
+	if strings.Contains(s, "/") {
+		p, err := netip.ParsePrefix(s)
+		return p.Masked(), err
+	}
+	addr, err := netip.ParseAddr(s)
+	if err != nil {
+		return netip.Prefix{}, err
+	}
+	return netip.PrefixFrom(addr.Unmap(), addr.Unmap().BitLen()), nil
+}
+
+// parseRangeList - Satır başına bir CIDR; boş satırlar ve # yorumları atlanır.
+func parseRangeList(r io.Reader) ([]netip.Prefix, error) {
+	var ranges []netip.Prefix
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		p, err := parsePrefix(line)
+		if err != nil {
+			return nil, fmt.Errorf("invalid range %q: %w", line, err)
+		}
+		ranges = append(ranges, p)
+	}
+	return ranges, scanner.Err()
+}
+
+func containsAddr(ranges []netip.Prefix, addr netip.Addr) bool {
+	for _, p := range ranges {
+		if p.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
@@ -1 +1,82 @@
 package crons
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/okanay/go-template/pkg/logger"
+)
+
+// Job, belirli aralıklarla çalışan arka plan işi.
+// Run fonksiyonu idempotent olmalı: birden fazla instance aynı işi çalıştırabilir.
+type Job struct {
+	Name     string
+	Interval time.Duration
+	Timeout  time.Duration // 0 ise Interval kullanılır
+	Run      func(ctx context.Context) error
+}
+
+type Scheduler struct {
+	jobs []Job
+	wg   sync.WaitGroup
+}
+
+func NewScheduler() *Scheduler {
+	return &Scheduler{}
+}
+
+// Add - Scheduler'a yeni bir iş ekler. Start'tan önce çağrılmalı.
+func (s *Scheduler) Add(job Job) {
+	s.jobs = append(s.jobs, job)
+}
+
+// Start - Her işi kendi goroutine'inde başlatır. ctx iptal edildiğinde işler durur.
+func (s *Scheduler) Start(ctx context.Context) {
+	for _, job := range s.jobs {
+		s.wg.Add(1)
+		go s.loop(ctx, job)
+	}
+}
+
+// Wait - Tüm işlerin durmasını bekler (graceful shutdown için).
+func (s *Scheduler) Wait() {
+	s.wg.Wait()
+}
+
+func (s *Scheduler) loop(ctx context.Context, job Job) {
+	defer s.wg.Done()
+
+	ticker := time.NewTicker(job.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.run(ctx, job)
+		}
+	}
+}
+
+func (s *Scheduler) run(ctx context.Context, job Job) {
+	timeout := job.Timeout
+	if timeout == 0 {
+		timeout = job.Interval
+	}
+
+	runCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	// Bir işin panic'i diğer işleri durdurmamalı
+	defer func() {
+		if r := recover(); r != nil {
+			logger.Component("cron").Error("job panicked", "job", job.Name, "panic", r)
+		}
+	}()
+
+	if err := job.Run(runCtx); err != nil {
+		logger.Component("cron").Error("job failed", "job", job.Name, "error", err)
+	}
+}
@@ -0,0 +1,54 @@
+package errreport
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// ═══════════════════════════════════════════════════════════════════
+// HATA RAPORLAMA
+// ═══════════════════════════════════════════════════════════════════
+// Panic'ler ve beklenmeyen hatalar loglanmanın yanında harici bir servise
+// (Sentry, Rollbar, Slack webhook vb.) gönderilebilir. Bu paket sadece arayüzü
+// tanımlar; SDK bağımlılığı eklemeden istenen servis bir Reporter ile bağlanır:
+//
+//	reporter := errreport.Func(func(ctx context.Context, e errreport.Event) {
+//		sentry.CaptureException(e.Err)
+//	})
+//	router.Use(mw.Recovery(reporter))
+
+// Event - Raporlanan tek bir hata. HTTP dışı kaynaklarda (cron) istek alanları boş kalır.
+type Event struct {
+	Err       error
+	Stack     []byte
+	Time      time.Time
+	RequestID string
+	Method    string
+	Route     string
+	Path      string
+	ClientIP  string
+	UserID    string
+}
+
+// Reporter - Report hızlı dönmeli; ağ çağrısı yapan implementasyonlar işi arka planda yapmalıdır.
+// Çağıran taraf isteği yanıtlamadan önce Report'u bekler.
+type Reporter interface {
+	Report(ctx context.Context, event Event)
+}
+
+// Func - Tek fonksiyonluk Reporter'lar için adaptör.
+type Func func(ctx context.Context, event Event)
+
+func (f Func) Report(ctx context.Context, event Event) {
+	f(ctx, event)
+}
+
+// PanicError - recover() değerini error'a çevirir. error olmayan panic'ler
+// (panic("x"), panic(42)) fmt ile metne dönüştürülür.
+func PanicError(recovered any) error {
+	if err, ok := recovered.(error); ok {
+		return err
+	}
+	return fmt.Errorf("panic: %v", recovered)
+}
@@ -0,0 +1,78 @@
+package i18n
+
+// catalogTR - İngilizce kaynak metin -> Türkçe çeviri.
+var catalogTR = map[string]string{
+	// ─── apierror ───────────────────────────────────────────────────
+	"Validation failed. Please check your input.":                                 "Doğrulama başarısız. Lütfen girdiğiniz bilgileri kontrol edin.",
+	"Internal server error. Please try again later.":                              "Sunucu hatası. Lütfen daha sonra tekrar deneyin.",
+	"Unauthorized. Authentication required.":                                      "Yetkisiz erişim. Giriş yapmanız gerekiyor.",
+	"Resource not found. Check your request.":                                     "Kaynak bulunamadı. İsteğinizi kontrol edin.",
+	"Forbidden. You don't have permission.":                                       "Bu işlem için yetkiniz yok.",
+	"Bad request. Invalid parameters.":                                            "Geçersiz istek. Parametreleri kontrol edin.",
+	"Conflict. Resource already exists.":                                          "Çakışma. Kaynak zaten mevcut.",
+	"Please confirm your password to continue.":                                   "Devam etmek için lütfen şifrenizi onaylayın.",
+	"Invalid credentials.":                                                        "Geçersiz kimlik bilgileri.",
+	"Too many requests. Please slow down.":                                        "Çok fazla istek gönderildi. Lütfen biraz bekleyin.",
+	"Bot verification failed. Please try again.":                                  "Bot doğrulaması başarısız. Lütfen tekrar deneyin.",
+	"Bot verification is temporarily unavailable.":                                "Bot doğrulaması geçici olarak kullanılamıyor.",
+	"A request with this Idempotency-Key is still being processed.":               "Bu Idempotency-Key ile gönderilen istek hâlâ işleniyor.",
+	"This Idempotency-Key was already used with a different request.":             "Bu Idempotency-Key daha önce farklı bir istekle kullanıldı.",
+	"Request body is too large.":                                                  "İstek gövdesi çok büyük.",
+	"The request took too long to process. Please try again.":                     "İstek çok uzun sürdü. Lütfen tekrar deneyin.",
+	"Exchange rates are temporarily unavailable.":                                 "Döviz kurları geçici olarak alınamıyor.",
+	"We are performing scheduled maintenance. Please try again shortly.":          "Planlı bakım çalışması yapıyoruz. Lütfen kısa süre sonra tekrar deneyin.",
+	"Changes are temporarily disabled for maintenance. Please try again shortly.": "Bakım nedeniyle değişiklikler geçici olarak kapalı. Lütfen kısa süre sonra tekrar deneyin.",
+	"Service is temporarily unavailable. Please try again later.":                 "Servis geçici olarak kullanılamıyor. Lütfen daha sonra tekrar deneyin.",
+	"Access from your network or location is not allowed.":                        "Bulunduğunuz ağdan veya konumdan erişime izin verilmiyor.",
+	"Webhook signature verification failed.":                                      "Webhook imza doğrulaması başarısız.",
+	"This webhook delivery is still being processed.":                             "Bu webhook teslimatı hâlâ işleniyor.",
+	"The end time must be in the future.":                                         "Bitiş zamanı gelecekte olmalıdır.",
+	"Session revoked, please login again":                                         "Oturum sonlandırıldı, lütfen tekrar giriş yapın",
+	"Session expired, please login again":                                         "Oturum süresi doldu, lütfen tekrar giriş yapın",
+	"MFA is not enabled for this account.":                                        "Bu hesapta iki adımlı doğrulama etkin değil.",
+	"Idempotency-Key is too long.":                                                "Idempotency-Key çok uzun.",
+	"Account deletion is already in progress.":                                    "Hesap silme işlemi zaten devam ediyor.",
+	"No pending deletion request.":                                                "Bekleyen bir hesap silme talebi yok.",
+	"Invalid IP address, CIDR range or country code.":                             "Geçersiz IP adresi, CIDR aralığı veya ülke kodu.",
+	"Invalid origin. Expected scheme://host[:port] or https://*.example.com.":     "Geçersiz origin. scheme://host[:port] veya https://*.example.com formatında olmalı.",
+	"Invalid report payload.":                                                     "Geçersiz rapor içeriği.",
+	"Invalid file type.":                                                          "Geçersiz dosya türü.",
+	"The key in the body does not match the URL.":                                 "Body'deki key URL ile eşleşmiyor.",
+
+	// ─── validator ──────────────────────────────────────────────────
+	"Request body must not exceed %d bytes":                                     "İstek gövdesi %d byte'ı geçmemelidir",
+	"Invalid data format: %v":                                                   "Geçersiz veri formatı: %v",
+	"The %s field is required.":                                                 "%s alanı zorunludur.",
+	"The %s field is required under certain conditions.":                        "%s alanı bazı koşullarda zorunludur.",
+	"The %s field is required when %s is not present.":                          "%s alanı, %s yoksa zorunludur.",
+	"The %s field only accepts the following file types: %s":                    "%s alanı sadece şu dosya türlerini kabul eder: %s",
+	"The %s field must be a valid email address.":                               "%s alanı geçerli bir e-posta adresi olmalıdır.",
+	"The %s field must be at least %s characters.":                              "%s alanı en az %s karakter olmalıdır.",
+	"The minimum value for the %s field is %s.":                                 "%s alanı için minimum değer %s.",
+	"The %s field must not be greater than %s characters.":                      "%s alanı en fazla %s karakter olabilir.",
+	"The maximum value for the %s field is %s.":                                 "%s alanı için maksimum değer %s.",
+	"The %s field must be exactly %s characters.":                               "%s alanı tam olarak %s karakter olmalıdır.",
+	"The %s field must contain exactly %s elements.":                            "%s alanı tam olarak %s eleman içermelidir.",
+	"The %s field must be greater than or equal to %s.":                         "%s alanı %s veya daha büyük olmalıdır.",
+	"The %s field must be less than or equal to %s.":                            "%s alanı %s veya daha küçük olmalıdır.",
+	"The %s field must be greater than %s.":                                     "%s alanı %s değerinden büyük olmalıdır.",
+	"The %s field must be less than %s.":                                        "%s alanı %s değerinden küçük olmalıdır.",
+	"The %s field must be one of the following values: [%s].":                   "%s alanı şu değerlerden biri olmalıdır: [%s].",
+	"The %s field must contain only alphabetic characters.":                     "%s alanı sadece harf içermelidir.",
+	"The %s field must contain only alphanumeric characters.":                   "%s alanı sadece harf ve rakam içermelidir.",
+	"The %s field must contain only numeric characters.":                        "%s alanı sadece rakam içermelidir.",
+	"The %s field must be a valid URL.":                                         "%s alanı geçerli bir URL olmalıdır.",
+	"The %s field must be a valid URI.":                                         "%s alanı geçerli bir URI olmalıdır.",
+	"The %s field must be a valid UUID.":                                        "%s alanı geçerli bir UUID olmalıdır.",
+	"The %s field must be a valid slug format (lowercase, number, and hyphen).": "%s alanı geçerli bir slug olmalıdır (küçük harf, rakam ve tire).",
+	"The %s field must be a valid date-time format.":                            "%s alanı geçerli bir tarih-saat formatında olmalıdır.",
+	"The %s field must contain '%s'.":                                           "%s alanı '%s' içermelidir.",
+	"The %s field must contain at least one of the following characters: %s.":   "%s alanı şu karakterlerden en az birini içermelidir: %s.",
+	"The %s field must not contain '%s'.":                                       "%s alanı '%s' içermemelidir.",
+	"The %s field must start with '%s'.":                                        "%s alanı '%s' ile başlamalıdır.",
+	"The %s field must end with '%s'.":                                          "%s alanı '%s' ile bitmelidir.",
+	"The %s field must be the same as the %s field.":                            "%s alanı %s alanı ile aynı olmalıdır.",
+	"The %s field must be different from the %s field.":                         "%s alanı %s alanından farklı olmalıdır.",
+	"The %s field must be a valid JSON format.":                                 "%s alanı geçerli bir JSON olmalıdır.",
+	"The '%s' rule for the %s field is not satisfied.":                          "%[2]s alanı için '%[1]s' kuralı sağlanmadı.",
+}
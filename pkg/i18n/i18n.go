@@ -0,0 +1,129 @@
+package i18n
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// ═══════════════════════════════════════════════════════════════════
+// LOCALE & MESAJ KATALOĞU
+// ═══════════════════════════════════════════════════════════════════
+// Kaynak dil İngilizcedir: mesajın İngilizce metni aynı zamanda katalog anahtarıdır
+// (gettext msgid mantığı). Bu sayede apierror.MsgXxx sabitleri ve handler'lardaki
+// sabit metinler değişmeden çevrilir; katalogda olmayan metin olduğu gibi döner.
+//
+// Yeni dil eklemek: catalog_<dil>.go dosyası + catalogs map'ine kayıt + Supported.
+
+const (
+	EN = "en"
+	TR = "tr"
+
+	Default = EN
+)
+
+// Supported - Negotiate'in kabul ettiği diller.
+var Supported = []string{EN, TR}
+
+var catalogs = map[string]map[string]string{
+	TR: catalogTR,
+}
+
+type contextKey struct{}
+
+// NewContext - Çözümlenen dili context'e yazar (middleware.Locale).
+func NewContext(ctx context.Context, lang string) context.Context {
+	return context.WithValue(ctx, contextKey{}, lang)
+}
+
+// FromContext - Context'teki dili döner, yoksa Default.
+func FromContext(ctx context.Context) string {
+	if lang, ok := ctx.Value(contextKey{}).(string); ok && lang != "" {
+		return lang
+	}
+	return Default
+}
+
+// T - Mesajı verilen dile çevirir. Çeviri yoksa mesajın kendisi (İngilizce) döner.
+func T(lang, msg string) string {
+	if translated, ok := catalogs[lang][msg]; ok {
+		return translated
+	}
+	return msg
+}
+
+// Sprintf - Format metnini çevirir, sonra argümanları yerleştirir.
+// Çeviri, argüman sırasını değiştirmek için %[2]s gibi indeksli fiiller kullanabilir.
+func Sprintf(lang, format string, args ...any) string {
+	return fmt.Sprintf(T(lang, format), args...)
+}
+
+// Negotiate - Adayları sırayla dener, desteklenen ilk dili döner.
+// "tr-TR", "tr_TR" ve "TR" gibi değerler "tr"ye indirgenir. Hiçbiri uymazsa Default.
+func Negotiate(candidates ...string) string {
+	for _, candidate := range candidates {
+		if lang, ok := Match(candidate); ok {
+			return lang
+		}
+	}
+	return Default
+}
+
+// Match - Tek bir dil etiketini desteklenen dillerle eşleştirir.
+func Match(tag string) (string, bool) {
+	tag = strings.ToLower(strings.TrimSpace(strings.ReplaceAll(tag, "_", "-")))
+	if tag == "" {
+		return "", false
+	}
+
+	base, _, _ := strings.Cut(tag, "-")
+	for _, lang := range Supported {
+		if tag == lang || base == lang {
+			return lang, true
+		}
+	}
+	return "", false
+}
+
+// ParseAcceptLanguage - "tr-TR,tr;q=0.9,en;q=0.8" header'ını q değerine göre sıralı döner.
+// q=0 olan diller ("istemiyorum") listeye alınmaz.
+func ParseAcceptLanguage(header string) []string {
+	type weighted struct {
+		tag string
+		q   float64
+	}
+
+	var items []weighted
+	for _, part := range strings.Split(header, ",") {
+		tag, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		tag = strings.TrimSpace(tag)
+		if tag == "" || tag == "*" {
+			continue
+		}
+
+		q := 1.0
+		if v, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+			parsed, err := strconv.ParseFloat(v, 64)
+			if err != nil {
+				continue
+			}
+			q = parsed
+		}
+		if q <= 0 {
+			continue
+		}
+
+		items = append(items, weighted{tag: tag, q: q})
+	}
+
+	// Eşit q değerlerinde header'daki sıra korunur
+	sort.SliceStable(items, func(i, j int) bool { return items[i].q > items[j].q })
+
+	tags := make([]string, len(items))
+	for i, item := range items {
+		tags[i] = item.tag
+	}
+	return tags
+}
@@ -0,0 +1,230 @@
+package loadshed
+
+import (
+	"container/list"
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/okanay/go-template/pkg/logger"
+)
+
+// ═══════════════════════════════════════════════════════════════════
+// CONCURRENCY LIMITER & LOAD SHEDDING
+// ═══════════════════════════════════════════════════════════════════
+// Rate limit "saniyede kaç istek" sorusunu, bu paket "aynı anda kaç istek" sorusunu cevaplar.
+// Postgres yavaşladığında istek sayısı değil işlemdeki istek sayısı artar; havuz
+// (SetMaxOpenConns) dolunca her şey birlikte timeout olur. Limiter bunu önler:
+//
+//  1. İşlemdeki istek < Limit ise hemen geçer
+//  2. Değilse kısa bir kuyrukta (QueueSize, QueueTimeout) bekler
+//  3. Kuyruk doluysa veya bekleme süresi dolarsa ErrShed döner (middleware 503 yazar)
+//
+// TargetLatency verilirse limit gözlenen gecikmeye göre AIMD ile ayarlanır: ortalama
+// gecikme hedefi aşarsa limit %10 düşer, hedefin altında ve limit doluysa 1 artar.
+// Limiter tek instance içindir (bellekte); her sınıf (auth, api, export) ayrı Limiter kullanır
+// ki bir sınıfın yığılması diğerinin kapasitesini tüketmesin.
+
+var ErrShed = errors.New("load shed: concurrency limit reached")
+
+const (
+	defaultQueueTimeout = 100 * time.Millisecond
+	adjustInterval      = time.Second
+	adjustSamples       = 50
+	decreaseFactor      = 0.9
+)
+
+type Config struct {
+	Name         string        // Log ve Stats için: "auth", "api", "export"
+	Limit        int           // Başlangıç (adaptive değilse sabit) eşzamanlılık limiti
+	QueueSize    int           // Limit doluyken bekleyebilecek istek sayısı, 0 ise kuyruk yok
+	QueueTimeout time.Duration // Kuyrukta maksimum bekleme, 0 ise 100ms
+
+	// TargetLatency > 0 ise adaptive mod. Limit, [MinLimit, MaxLimit] aralığında değişir.
+	TargetLatency time.Duration
+	MinLimit      int // 0 ise Limit/4 (en az 1)
+	MaxLimit      int // 0 ise Limit: limit sadece aşağı çekilir, sonra tekrar Limit'e çıkar
+}
+
+type Stats struct {
+	Name     string `json:"name"`
+	Limit    int    `json:"limit"`
+	InFlight int    `json:"inFlight"`
+	Queued   int    `json:"queued"`
+	Shed     uint64 `json:"shed"`
+}
+
+type Limiter struct {
+	cfg Config
+
+	mu       sync.Mutex
+	limit    int
+	inFlight int
+	queue    *list.List // *waiter, FIFO
+	shed     uint64
+
+	// Adaptive pencere
+	windowStart time.Time
+	windowSum   time.Duration
+	windowCount int
+	windowPeak  int
+}
+
+type waiter struct {
+	ready   chan struct{}
+	granted bool
+}
+
+func New(cfg Config) *Limiter {
+	if cfg.Limit < 1 {
+		cfg.Limit = 1
+	}
+	if cfg.QueueTimeout <= 0 {
+		cfg.QueueTimeout = defaultQueueTimeout
+	}
+	if cfg.MinLimit <= 0 {
+		cfg.MinLimit = max(1, cfg.Limit/4)
+	}
+	if cfg.MaxLimit <= 0 {
+		cfg.MaxLimit = cfg.Limit
+	}
+
+	return &Limiter{
+		cfg:         cfg,
+		limit:       cfg.Limit,
+		queue:       list.New(),
+		windowStart: time.Now(),
+	}
+}
+
+// Acquire - Slot alır. Dönen release fonksiyonu istek bitince mutlaka çağrılmalıdır.
+// Kuyrukta beklerken ctx iptal edilirse (client gitti, deadline doldu) ctx.Err() döner.
+func (l *Limiter) Acquire(ctx context.Context) (release func(), err error) {
+	l.mu.Lock()
+	if l.inFlight < l.limit {
+		l.grantLocked()
+		l.mu.Unlock()
+		return l.releaseFunc(), nil
+	}
+
+	if l.queue.Len() >= l.cfg.QueueSize {
+		l.shed++
+		l.mu.Unlock()
+		return nil, ErrShed
+	}
+
+	w := &waiter{ready: make(chan struct{})}
+	elem := l.queue.PushBack(w)
+	l.mu.Unlock()
+
+	timer := time.NewTimer(l.cfg.QueueTimeout)
+	defer timer.Stop()
+
+	select {
+	case <-w.ready:
+		return l.releaseFunc(), nil
+	case <-timer.C:
+		err = ErrShed
+	case <-ctx.Done():
+		err = ctx.Err()
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	// Timeout ile slot verilmesi aynı anda olduysa slot kullanılır
+	if w.granted {
+		return l.releaseFunc(), nil
+	}
+	l.queue.Remove(elem)
+	if errors.Is(err, ErrShed) {
+		l.shed++
+	}
+	return nil, err
+}
+
+func (l *Limiter) Name() string {
+	return l.cfg.Name
+}
+
+func (l *Limiter) Stats() Stats {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return Stats{
+		Name:     l.cfg.Name,
+		Limit:    l.limit,
+		InFlight: l.inFlight,
+		Queued:   l.queue.Len(),
+		Shed:     l.shed,
+	}
+}
+
+func (l *Limiter) grantLocked() {
+	l.inFlight++
+	l.windowPeak = max(l.windowPeak, l.inFlight)
+}
+
+func (l *Limiter) releaseFunc() func() {
+	start := time.Now()
+	var once sync.Once
+	return func() {
+		once.Do(func() { l.release(time.Since(start)) })
+	}
+}
+
+func (l *Limiter) release(latency time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.inFlight--
+	if l.cfg.TargetLatency > 0 {
+		l.observeLocked(latency)
+	}
+	l.wakeLocked()
+}
+
+// wakeLocked - Limit izin verdiği kadar kuyruktaki isteği sırayla geçirir.
+func (l *Limiter) wakeLocked() {
+	for l.inFlight < l.limit && l.queue.Len() > 0 {
+		w := l.queue.Remove(l.queue.Front()).(*waiter)
+		w.granted = true
+		l.grantLocked()
+		close(w.ready)
+	}
+}
+
+// observeLocked - AIMD: pencere (1 sn veya 50 örnek) dolunca limiti günceller.
+// Gecikme, kuyrukta beklenen süreyi içermez; sadece işlem süresi ölçülür.
+func (l *Limiter) observeLocked(latency time.Duration) {
+	l.windowSum += latency
+	l.windowCount++
+
+	now := time.Now()
+	if l.windowCount < adjustSamples && now.Sub(l.windowStart) < adjustInterval {
+		return
+	}
+
+	avg := l.windowSum / time.Duration(l.windowCount)
+	previous := l.limit
+	switch {
+	case avg > l.cfg.TargetLatency:
+		l.limit = max(l.cfg.MinLimit, min(l.limit-1, int(float64(l.limit)*decreaseFactor)))
+	case l.windowPeak >= l.limit:
+		// Limit gerçekten kullanıldıysa artır; boşta bir sınıfın limiti anlamsızca büyümesin
+		l.limit = min(l.cfg.MaxLimit, l.limit+1)
+	}
+
+	if l.limit != previous {
+		logger.Component("loadshed").Info("concurrency limit adjusted",
+			"class", l.cfg.Name,
+			"from", previous,
+			"to", l.limit,
+			"avg_latency_ms", avg.Milliseconds(),
+		)
+	}
+
+	l.windowStart = now
+	l.windowSum = 0
+	l.windowCount = 0
+	l.windowPeak = l.inFlight
+}
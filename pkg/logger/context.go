@@ -0,0 +1,29 @@
+package logger
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/okanay/go-template/pkg/requestid"
+)
+
+// contextHandler - *Context log fonksiyonlarına verilen ctx'ten request_id'yi okuyup
+// her satıra ekler. logger.Component("x").ErrorContext(ctx, ...) yeterlidir.
+type contextHandler struct {
+	slog.Handler
+}
+
+func (h contextHandler) Handle(ctx context.Context, r slog.Record) error {
+	if id := requestid.FromContext(ctx); id != "" {
+		r.AddAttrs(slog.String("request_id", id))
+	}
+	return h.Handler.Handle(ctx, r)
+}
+
+func (h contextHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return contextHandler{h.Handler.WithAttrs(attrs)}
+}
+
+func (h contextHandler) WithGroup(name string) slog.Handler {
+	return contextHandler{h.Handler.WithGroup(name)}
+}
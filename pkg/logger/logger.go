@@ -0,0 +1,103 @@
+package logger
+
+import (
+	"io"
+	"log"
+	"log/slog"
+	"os"
+	"strings"
+)
+
+type Format string
+
+const (
+	FormatJSON Format = "json"
+	FormatText Format = "text"
+)
+
+type Config struct {
+	Format Format     // json (production) veya text (lokal geliştirme)
+	Level  slog.Level // debug, info, warn, error
+	Output io.Writer  // nil ise os.Stdout
+}
+
+// ConfigFromEnv - LOG_FORMAT ve LOG_LEVEL değerlerini okur.
+// LOG_FORMAT boşsa GIN_MODE=debug'da text, diğer durumlarda json kullanılır.
+func ConfigFromEnv() Config {
+	format := Format(strings.ToLower(os.Getenv("LOG_FORMAT")))
+	if format != FormatJSON && format != FormatText {
+		format = FormatJSON
+		if os.Getenv("GIN_MODE") == "debug" {
+			format = FormatText
+		}
+	}
+
+	return Config{
+		Format: format,
+		Level:  ParseLevel(os.Getenv("LOG_LEVEL")),
+	}
+}
+
+// ParseLevel - Bilinmeyen değerlerde info döner.
+func ParseLevel(s string) slog.Level {
+	var level slog.Level
+	if err := level.UnmarshalText([]byte(s)); err != nil {
+		return slog.LevelInfo
+	}
+	return level
+}
+
+// level - Init ile kurulan varsayılan logger'ın seviyesi. SetLevel ile restart olmadan değişir.
+var level = new(slog.LevelVar)
+
+// SetLevel - Varsayılan logger'ın seviyesini çalışma anında değiştirir (config reload).
+func SetLevel(l slog.Level) {
+	level.Set(l)
+}
+
+// New - Redaction kuralları uygulanmış bir slog.Logger üretir.
+func New(cfg Config) *slog.Logger {
+	return newLogger(cfg, cfg.Level)
+}
+
+func newLogger(cfg Config, leveler slog.Leveler) *slog.Logger {
+	out := cfg.Output
+	if out == nil {
+		out = os.Stdout
+	}
+
+	opts := &slog.HandlerOptions{
+		Level:       leveler,
+		ReplaceAttr: redactAttr,
+	}
+
+	var handler slog.Handler
+	if cfg.Format == FormatText {
+		handler = slog.NewTextHandler(out, opts)
+	} else {
+		handler = slog.NewJSONHandler(out, opts)
+	}
+
+	return slog.New(contextHandler{handler})
+}
+
+// Init - Uygulama genelindeki varsayılan logger'ı ayarlar.
+// Standart "log" paketine yazan 3. parti kütüphaneler de aynı handler'a yönlendirilir.
+func Init(cfg Config) *slog.Logger {
+	level.Set(cfg.Level)
+	l := newLogger(cfg, level)
+	slog.SetDefault(l)
+	log.SetFlags(0)
+	return l
+}
+
+// Component - Log satırlarına "component" alanı ekler: logger.Component("redis").Error(...)
+func Component(name string) *slog.Logger {
+	return slog.Default().With("component", name)
+}
+
+// Fatal - Hata loglar ve uygulamayı sonlandırır (log.Fatalf karşılığı).
+func Fatal(l *slog.Logger, msg string, args ...any) {
+	l.Error(msg, args...)
+	os.Exit(1)
+}
@@ -0,0 +1,201 @@
+package money
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// ═══════════════════════════════════════════════════════════════════
+// MONEY
+// ═══════════════════════════════════════════════════════════════════
+// Tutarlar her zaman para biriminin en küçük biriminde (kuruş, cent) int64 olarak tutulur.
+// float64 kullanılmaz: 0.1 + 0.2 != 0.3 hatası fiyat hesaplarında kabul edilemez.
+// Kur dönüşümü math/big.Rat ile tam hassasiyette yapılır, sadece sonuç yuvarlanır.
+
+type Currency string
+
+const (
+	TRY Currency = "TRY"
+	EUR Currency = "EUR"
+	USD Currency = "USD"
+)
+
+// Default - Context'te para birimi yoksa (cron, iç çağrılar) kullanılır.
+const Default = TRY
+
+// Supported - Satış yapılan para birimleri.
+var Supported = []Currency{TRY, EUR, USD}
+
+var (
+	ErrUnsupportedCurrency = errors.New("unsupported currency")
+	ErrCurrencyMismatch    = errors.New("currency mismatch")
+)
+
+// ParseCurrency - "try", " EUR " gibi değerleri normalize eder.
+func ParseCurrency(s string) (Currency, error) {
+	c := Currency(strings.ToUpper(strings.TrimSpace(s)))
+	for _, supported := range Supported {
+		if c == supported {
+			return c, nil
+		}
+	}
+	return "", ErrUnsupportedCurrency
+}
+
+// MinorUnits - Ondalık basamak sayısı (ISO 4217). TRY, EUR ve USD için 2.
+func (c Currency) MinorUnits() int {
+	return 2
+}
+
+// Symbol - Görüntüleme için para birimi sembolü.
+func (c Currency) Symbol() string {
+	switch c {
+	case TRY:
+		return "₺"
+	case EUR:
+		return "€"
+	case USD:
+		return "$"
+	default:
+		return string(c)
+	}
+}
+
+type Money struct {
+	Amount   int64    `json:"amount"` // Minor unit: 1999 = 19.99
+	Currency Currency `json:"currency"`
+}
+
+func New(amount int64, currency Currency) Money {
+	return Money{Amount: amount, Currency: currency}
+}
+
+func (m Money) Add(other Money) (Money, error) {
+	if m.Currency != other.Currency {
+		return Money{}, ErrCurrencyMismatch
+	}
+	return Money{Amount: m.Amount + other.Amount, Currency: m.Currency}, nil
+}
+
+// Convert - rate: 1 birim m.Currency = rate birim to.
+// Sonuç en yakın minor unit'e yuvarlanır (yarım değerler sıfırdan uzağa).
+func (m Money) Convert(to Currency, rate *big.Rat) Money {
+	if m.Currency == to {
+		return m
+	}
+
+	// amount * rate * 10^(to.MinorUnits - from.MinorUnits)
+	r := new(big.Rat).Mul(big.NewRat(m.Amount, 1), rate)
+	if diff := to.MinorUnits() - m.Currency.MinorUnits(); diff != 0 {
+		scale := new(big.Rat).SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(abs(diff))), nil))
+		if diff > 0 {
+			r.Mul(r, scale)
+		} else {
+			r.Quo(r, scale)
+		}
+	}
+
+	return Money{Amount: roundHalfAwayFromZero(r), Currency: to}
+}
+
+// Decimal - "1234.56" biçiminde, binlik ayraçsız.
+func (m Money) Decimal() string {
+	units := m.Currency.MinorUnits()
+	sign := ""
+	amount := m.Amount
+	if amount < 0 {
+		sign = "-"
+		amount = -amount
+	}
+
+	s := strconv.FormatInt(amount, 10)
+	if units == 0 {
+		return sign + s
+	}
+	if len(s) <= units {
+		s = strings.Repeat("0", units-len(s)+1) + s
+	}
+	return sign + s[:len(s)-units] + "." + s[len(s)-units:]
+}
+
+func (m Money) String() string {
+	return m.Decimal() + " " + string(m.Currency)
+}
+
+// Format - Dile göre görüntüleme: tr -> "1.234,56 ₺", diğerleri -> "₺1,234.56"
+func (m Money) Format(lang string) string {
+	intPart, fracPart, _ := strings.Cut(m.Decimal(), ".")
+	negative := strings.HasPrefix(intPart, "-")
+	intPart = strings.TrimPrefix(intPart, "-")
+
+	thousands, decimal := ",", "."
+	if lang == "tr" {
+		thousands, decimal = ".", ","
+	}
+
+	var sb strings.Builder
+	for i, digit := range intPart {
+		if i > 0 && (len(intPart)-i)%3 == 0 {
+			sb.WriteString(thousands)
+		}
+		sb.WriteRune(digit)
+	}
+	number := sb.String()
+	if fracPart != "" {
+		number += decimal + fracPart
+	}
+
+	sign := ""
+	if negative {
+		sign = "-"
+	}
+
+	if lang == "tr" {
+		return fmt.Sprintf("%s%s %s", sign, number, m.Currency.Symbol())
+	}
+	return sign + m.Currency.Symbol() + number
+}
+
+func roundHalfAwayFromZero(r *big.Rat) int64 {
+	num := new(big.Int).Set(r.Num())
+	den := r.Denom()
+
+	q, rem := new(big.Int).QuoRem(num, den, new(big.Int))
+	// |rem| * 2 >= den ise bir üst birime yuvarla
+	if new(big.Int).Mul(new(big.Int).Abs(rem), big.NewInt(2)).Cmp(den) >= 0 {
+		if num.Sign() < 0 {
+			q.Sub(q, big.NewInt(1))
+		} else {
+			q.Add(q, big.NewInt(1))
+		}
+	}
+	return q.Int64()
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
+
+// ─── Request context ─────────────────────────────────────────────
+
+type contextKey struct{}
+
+// NewContext - middleware.Currency'nin çözümlediği para birimini context'e yazar.
+func NewContext(ctx context.Context, currency Currency) context.Context {
+	return context.WithValue(ctx, contextKey{}, currency)
+}
+
+// FromContext - İsteğin para birimi; yoksa Default.
+func FromContext(ctx context.Context) Currency {
+	if c, ok := ctx.Value(contextKey{}).(Currency); ok && c != "" {
+		return c
+	}
+	return Default
+}
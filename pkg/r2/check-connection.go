@@ -6,13 +6,19 @@ import (
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/okanay/go-template/pkg/logger"
 )
 
 func (r *R2) TestConnection(ctx context.Context) error {
-	listResult, err := r.client.ListObjectsV2(ctx, &s3.ListObjectsV2Input{
-		Bucket:  &r.bucketName,
-		Prefix:  &r.folderName,
-		MaxKeys: aws.Int32(1),
+	var listResult *s3.ListObjectsV2Output
+	err := r.dep.Do(ctx, func(ctx context.Context) error {
+		var err error
+		listResult, err = r.client.ListObjectsV2(ctx, &s3.ListObjectsV2Input{
+			Bucket:  &r.bucketName,
+			Prefix:  &r.folderName,
+			MaxKeys: aws.Int32(1),
+		})
+		return err
 	})
 
 	if err != nil {
@@ -20,6 +26,6 @@ func (r *R2) TestConnection(ctx context.Context) error {
 	}
 
 	// Başarılı bağlantı, nesne sayısını kontrol et
-	fmt.Printf("R2 bağlantı testi başarılı! %d nesne listelendi.\n", len(listResult.Contents))
+	logger.Component("r2").Info("connection test succeeded", "bucket", r.bucketName, "listed", len(listResult.Contents))
 	return nil
 }
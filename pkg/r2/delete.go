@@ -9,9 +9,13 @@ import (
 )
 
 func (r *R2) DeleteObject(ctx context.Context, objectKey string) error {
-	_, err := r.client.DeleteObject(ctx, &s3.DeleteObjectInput{
-		Bucket: aws.String(r.bucketName),
-		Key:    aws.String(objectKey),
+	// S3 DELETE idempotenttir: tekrar denemek güvenli
+	err := r.dep.Do(ctx, func(ctx context.Context) error {
+		_, err := r.client.DeleteObject(ctx, &s3.DeleteObjectInput{
+			Bucket: aws.String(r.bucketName),
+			Key:    aws.String(objectKey),
+		})
+		return err
 	})
 
 	if err != nil {
@@ -13,6 +13,7 @@ type UploadOutput struct {
 	PresignedURL string    `json:"presignedUrl"`
 	UploadURL    string    `json:"uploadUrl"`
 	ObjectKey    string    `json:"objectKey"`
+	Category     string    `json:"category"`
 	ExpiresAt    time.Time `json:"expiresAt"`
 }
 
@@ -3,11 +3,14 @@ package r2
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	awsretry "github.com/aws/aws-sdk-go-v2/aws/retry"
 	"github.com/aws/aws-sdk-go-v2/config"
 	"github.com/aws/aws-sdk-go-v2/credentials"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/okanay/go-template/pkg/resilience"
 )
 
 type R2 struct {
@@ -16,6 +19,20 @@ type R2 struct {
 	bucketName    string
 	folderName    string
 	publicURLBase string
+	dep           *resilience.Dependency
+}
+
+// r2Retryables - SDK'nın kendi retry sınıflandırması (5xx, throttling, bağlantı hataları).
+// NotFound gibi iş mantığı hataları tekrarlanmaz ve breaker'ı açmaz.
+var (
+	r2Retryables = awsretry.IsErrorRetryables(awsretry.DefaultRetryables)
+	r2Throttles  = awsretry.IsErrorThrottles(awsretry.DefaultThrottles)
+)
+
+func isRetryable(err error) bool {
+	return resilience.IsTransient(err) ||
+		r2Retryables.IsErrorRetryable(err).Bool() ||
+		r2Throttles.IsErrorThrottle(err).Bool()
 }
 
 func NewR2Client(ctx context.Context, accountID, accessKeyID, accessKeySecret, bucketName, folderName, publicURLBase, endpoint string) (*R2, error) {
@@ -36,10 +53,16 @@ func NewR2Client(ctx context.Context, accountID, accessKeyID, accessKeySecret, b
 	s3Client := s3.NewFromConfig(cfg, func(o *s3.Options) {
 		o.BaseEndpoint = aws.String(endpoint)
 		o.UsePathStyle = true // R2 için önemli
+		o.APIOptions = append(o.APIOptions, addRequestIDHeader)
+		// Retry pkg/resilience'ta yapılır; SDK'nın 3 denemesiyle çarpılıp 9 denemeye çıkmasın
+		o.Retryer = aws.NopRetryer{}
 	})
 
 	// Presign client'ı bir kere oluşturup reuse ediyoruz
-	presignClient := s3.NewPresignClient(s3Client)
+	// Request ID header'ı presigned URL imzasına girmesin diye APIOptions temizleniyor.
+	presignClient := s3.NewPresignClient(s3Client, s3.WithPresignClientFromClientOptions(func(o *s3.Options) {
+		o.APIOptions = nil
+	}))
 
 	return &R2{
 		client:        s3Client,
@@ -47,5 +70,12 @@ func NewR2Client(ctx context.Context, accountID, accessKeyID, accessKeySecret, b
 		bucketName:    bucketName,
 		folderName:    folderName,
 		publicURLBase: publicURLBase,
+		dep: resilience.New(resilience.Config{
+			Name:          "r2",
+			Retry:         resilience.RetryPolicy{MaxAttempts: 3, Retryable: isRetryable},
+			MaxConcurrent: 32,
+			MaxQueued:     64,
+			MaxWait:       time.Second,
+		}),
 	}, nil
 }
@@ -45,6 +45,7 @@ func (r *R2) GeneratePresignedURL(ctx context.Context, input UploadInput) (*Uplo
 		PresignedURL: req.URL,
 		UploadURL:    publicURL,
 		ObjectKey:    objectKey,
+		Category:     category,
 		ExpiresAt:    time.Now().Add(expiry),
 	}, nil
 }
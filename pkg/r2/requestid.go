@@ -0,0 +1,24 @@
+package r2
+
+import (
+	"context"
+
+	"github.com/aws/smithy-go/middleware"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+	"github.com/okanay/go-template/pkg/requestid"
+)
+
+// addRequestIDHeader - ctx'te request ID varsa R2'ye giden her isteğe X-Request-Id ekler.
+// Presign client'a eklenmez: header imzaya girer ve tarayıcının da göndermesi gerekirdi.
+func addRequestIDHeader(stack *middleware.Stack) error {
+	return stack.Build.Add(middleware.BuildMiddlewareFunc("RequestIDHeader",
+		func(ctx context.Context, in middleware.BuildInput, next middleware.BuildHandler) (middleware.BuildOutput, middleware.Metadata, error) {
+			if id := requestid.FromContext(ctx); id != "" {
+				if req, ok := in.Request.(*smithyhttp.Request); ok {
+					req.Header.Set(requestid.Header, id)
+				}
+			}
+			return next.HandleBuild(ctx, in)
+		},
+	), middleware.After)
+}
@@ -12,9 +12,14 @@ import (
 )
 
 func (r *R2) VerifyFileExists(ctx context.Context, objectKey string) (*FileMetadata, error) {
-	output, err := r.client.HeadObject(ctx, &s3.HeadObjectInput{
-		Bucket: aws.String(r.bucketName),
-		Key:    aws.String(objectKey),
+	var output *s3.HeadObjectOutput
+	err := r.dep.Do(ctx, func(ctx context.Context) error {
+		var err error
+		output, err = r.client.HeadObject(ctx, &s3.HeadObjectInput{
+			Bucket: aws.String(r.bucketName),
+			Key:    aws.String(objectKey),
+		})
+		return err
 	})
 
 	if err != nil {
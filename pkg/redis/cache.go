@@ -5,6 +5,8 @@ import (
 	"time"
 
 	"github.com/goccy/go-json"
+	"github.com/okanay/go-template/pkg/logger"
+	"github.com/okanay/go-template/pkg/requestid"
 	"github.com/redis/go-redis/v9"
 )
 
@@ -52,7 +54,8 @@ func GetItem[T Cacheable](
 			return result, nil // Cache Hit
 		}
 	} else if err != redis.Nil {
-		// Redis hatası (bağlantı vs), loglanabilir ama akışı bozmayalım
+		// Redis hatası (bağlantı vs), loglayalım ama akışı bozmayalım
+		logger.Component("redis").WarnContext(ctx, "cache read failed", "key", key, "error", err)
 	}
 
 	// 2. Cache Miss -> DB'den çek
@@ -64,7 +67,7 @@ func GetItem[T Cacheable](
 
 	// 3. Asenkron olarak Redis'e yaz (Cache Aside)
 	go func() {
-		bgCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		bgCtx, cancel := context.WithTimeout(requestid.Detach(ctx), 5*time.Second)
 		defer cancel()
 
 		pipe := rdb.client.Pipeline()
@@ -88,7 +91,9 @@ func GetItem[T Cacheable](
 			pipe.Expire(bgCtx, depKey, expiration)
 		}
 
-		_, _ = pipe.Exec(bgCtx)
+		if _, err := pipe.Exec(bgCtx); err != nil {
+			logger.Component("redis").WarnContext(bgCtx, "cache write failed", "domain", domain, "error", err)
+		}
 	}()
 
 	return data, nil
@@ -180,7 +185,7 @@ func GetList[T Cacheable](
 
 	// 3. Asenkron Kayıt (Cache Aside)
 	go func() {
-		bgCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second) // Liste yazma uzun sürebilir
+		bgCtx, cancel := context.WithTimeout(requestid.Detach(ctx), 10*time.Second) // Liste yazma uzun sürebilir
 		defer cancel()
 
 		pipe := rdb.client.Pipeline()
@@ -218,7 +223,9 @@ func GetList[T Cacheable](
 		idsJSON, _ := json.Marshal(ids)
 		pipe.Set(bgCtx, listKey, idsJSON, expiration)
 
-		_, _ = pipe.Exec(bgCtx)
+		if _, err := pipe.Exec(bgCtx); err != nil {
+			logger.Component("redis").WarnContext(bgCtx, "cache write failed", "domain", domain, "error", err)
+		}
 	}()
 
 	return data, nil
@@ -47,6 +47,8 @@ func Initialize(addr []string, username, password string, dbStr string) error {
 			return
 		}
 
+		rdb.AddHook(traceHook{})
+
 		instance = &RedisClient{client: rdb}
 	})
 
@@ -0,0 +1,52 @@
+package redis
+
+import (
+	"context"
+	"errors"
+	"net"
+	"time"
+
+	"github.com/okanay/go-template/pkg/logger"
+	"github.com/redis/go-redis/v9"
+)
+
+// traceHook - Redis protokolü header taşımadığı için request ID'yi komutun kendisine
+// ekleyemiyoruz. Bunun yerine her komut, çağıran isteğin ctx'i ile loglanır;
+// logger ctx'teki request_id'yi satıra ekler. Böylece bir hata ID üzerinden
+// Redis çağrılarına kadar izlenebilir. Başarılı komutlar debug seviyesinde yazılır.
+type traceHook struct{}
+
+func (traceHook) DialHook(next redis.DialHook) redis.DialHook {
+	return func(ctx context.Context, network, addr string) (net.Conn, error) {
+		return next(ctx, network, addr)
+	}
+}
+
+func (traceHook) ProcessHook(next redis.ProcessHook) redis.ProcessHook {
+	return func(ctx context.Context, cmd redis.Cmder) error {
+		start := time.Now()
+		err := next(ctx, cmd)
+		logCommand(ctx, cmd.Name(), 1, time.Since(start), err)
+		return err
+	}
+}
+
+func (traceHook) ProcessPipelineHook(next redis.ProcessPipelineHook) redis.ProcessPipelineHook {
+	return func(ctx context.Context, cmds []redis.Cmder) error {
+		start := time.Now()
+		err := next(ctx, cmds)
+		logCommand(ctx, "pipeline", len(cmds), time.Since(start), err)
+		return err
+	}
+}
+
+func logCommand(ctx context.Context, name string, count int, took time.Duration, err error) {
+	l := logger.Component("redis")
+
+	if err != nil && !errors.Is(err, redis.Nil) {
+		l.WarnContext(ctx, "command failed", "cmd", name, "count", count, "took_ms", took.Milliseconds(), "error", err)
+		return
+	}
+
+	l.DebugContext(ctx, "command", "cmd", name, "count", count, "took_ms", took.Milliseconds())
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 	"sync"
+
+	"github.com/okanay/go-template/pkg/logger"
 )
 
 // InvalidateItem: Bir içeriği siler.
@@ -82,7 +84,9 @@ func InvalidateByDependency(ctx context.Context, depDomain string, depId string)
 	}
 
 	// Son olarak dependency setini temizle
-	rdb.client.Del(ctx, depKey)
+	if err := rdb.client.Del(ctx, depKey).Err(); err != nil {
+		logger.Component("redis").WarnContext(ctx, "dependency set cleanup failed", "key", depKey, "error", err)
+	}
 
 	return nil
 }
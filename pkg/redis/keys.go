@@ -30,6 +30,16 @@ func BuildKeyDep(domain string, id string) string {
 	return fmt.Sprintf("%s:deps:%s:%s", KeyPrefix, domain, id)
 }
 
+// BuildKey -> app:auth:revoked:<id> (Domain/ID kalıbına uymayan serbest key'ler)
+func BuildKey(parts ...string) string {
+	return KeyPrefix + ":" + strings.Join(parts, ":")
+}
+
+// BuildKeyResponse -> app:resp:<route>:<variant> (HTTP response cache)
+func BuildKeyResponse(route string, variant string) string {
+	return fmt.Sprintf("%s:resp:%s:%s", KeyPrefix, route, variant)
+}
+
 // BuildKeyList -> app:blog:list:page=1:sort=desc
 func BuildKeyList(domain string, params map[string]string) string {
 	if len(params) == 0 {
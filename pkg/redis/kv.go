@@ -0,0 +1,80 @@
+package redis
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// ═══════════════════════════════════════════════════════════════════
+// KEY/VALUE HELPERS
+// ═══════════════════════════════════════════════════════════════════
+// Cacheable yapısına uymayan basit değerler (session marker, flag vs.)
+// için ince wrapper'lar. Key'ler her zaman Build* fonksiyonlarıyla üretilmeli.
+
+// ErrNotFound, key Redis'te bulunmadığında döner.
+var ErrNotFound = errors.New("redis: key not found")
+
+// GetValue - Key'in string değerini döner. Key yoksa ErrNotFound.
+func GetValue(ctx context.Context, key string) (string, error) {
+	val, err := GetClient().client.Get(ctx, key).Result()
+	if errors.Is(err, redis.Nil) {
+		return "", ErrNotFound
+	}
+	return val, err
+}
+
+// SetValue - Key'e değer yazar. expiration 0 ise süresiz tutulur.
+func SetValue(ctx context.Context, key string, value any, expiration time.Duration) error {
+	return GetClient().client.Set(ctx, key, value, expiration).Err()
+}
+
+// DeleteValue - Key'leri non-blocking olarak siler.
+func DeleteValue(ctx context.Context, keys ...string) error {
+	if len(keys) == 0 {
+		return nil
+	}
+	return GetClient().client.Unlink(ctx, keys...).Err()
+}
+
+// GetValueEx - Değeri okur ve TTL'i yeniler (sliding expiration). Key yoksa ErrNotFound.
+func GetValueEx(ctx context.Context, key string, expiration time.Duration) (string, error) {
+	val, err := GetClient().client.GetEx(ctx, key, expiration).Result()
+	if errors.Is(err, redis.Nil) {
+		return "", ErrNotFound
+	}
+	return val, err
+}
+
+// AddToSet - Set'e eleman ekler ve set'in TTL'ini günceller.
+func AddToSet(ctx context.Context, key string, member string, expiration time.Duration) error {
+	pipe := GetClient().client.Pipeline()
+	pipe.SAdd(ctx, key, member)
+	pipe.Expire(ctx, key, expiration)
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+// RemoveFromSet - Set'ten eleman çıkarır.
+func RemoveFromSet(ctx context.Context, key string, members ...string) error {
+	if len(members) == 0 {
+		return nil
+	}
+	args := make([]any, len(members))
+	for i, m := range members {
+		args[i] = m
+	}
+	return GetClient().client.SRem(ctx, key, args...).Err()
+}
+
+// SetMembers - Set'in tüm elemanlarını döner.
+func SetMembers(ctx context.Context, key string) ([]string, error) {
+	return GetClient().client.SMembers(ctx, key).Result()
+}
+
+// SetValueNX - Key yoksa yazar ve true döner; varsa dokunmaz ve false döner (dağıtık kilit).
+func SetValueNX(ctx context.Context, key string, value any, expiration time.Duration) (bool, error) {
+	return GetClient().client.SetNX(ctx, key, value, expiration).Result()
+}
@@ -0,0 +1,101 @@
+package redis
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// ═══════════════════════════════════════════════════════════════════
+// RATE LIMIT (GCRA - Generic Cell Rate Algorithm)
+// ═══════════════════════════════════════════════════════════════════
+// Her key için Redis'te sadece tek bir değer tutulur: TAT (Theoretical Arrival Time).
+// Hesaplama tamamen Lua içinde yapılır, bu yüzden birden fazla instance aynı
+// key'i aynı anda güncellese bile sonuç atomiktir. Saat olarak Redis'in TIME
+// komutu kullanılır; instance'lar arası saat farkı sonucu etkilemez.
+
+// gcraScript
+// KEYS[1] = rate limit key
+// ARGV[1] = burst, ARGV[2] = rate (period başına istek), ARGV[3] = period (saniye), ARGV[4] = cost
+// Dönüş: {allowed, remaining, retry_after, reset_after} (süreler string, saniye cinsinden)
+var gcraScript = redis.NewScript(`
+redis.replicate_commands()
+
+local key = KEYS[1]
+local burst = tonumber(ARGV[1])
+local rate = tonumber(ARGV[2])
+local period = tonumber(ARGV[3])
+local cost = tonumber(ARGV[4])
+
+local emission_interval = period / rate
+local increment = emission_interval * cost
+local burst_offset = emission_interval * burst
+
+local t = redis.call("TIME")
+local now = tonumber(t[1]) + tonumber(t[2]) / 1000000
+
+local tat = redis.call("GET", key)
+if not tat then
+  tat = now
+else
+  tat = tonumber(tat)
+end
+tat = math.max(tat, now)
+
+local new_tat = tat + increment
+local allow_at = new_tat - burst_offset
+local diff = now - allow_at
+local remaining = diff / emission_interval
+
+if remaining < 0 then
+  return {0, 0, tostring(-diff), tostring(tat - now)}
+end
+
+local reset_after = new_tat - now
+if reset_after > 0 then
+  redis.call("SET", key, tostring(new_tat), "EX", math.ceil(reset_after))
+end
+
+return {1, math.floor(remaining), "0", tostring(reset_after)}
+`)
+
+type RateLimitResult struct {
+	Allowed    bool
+	Limit      int
+	Remaining  int
+	RetryAfter time.Duration // Allowed=false ise bir sonraki isteğe kadar beklenecek süre
+	ResetAfter time.Duration // Limit'in tamamen dolmasına kalan süre
+}
+
+// AllowRate - GCRA ile key için bir istek (cost=1) harcamayı dener.
+// limit: period başına izin verilen istek, burst: art arda izin verilen maksimum istek.
+func AllowRate(ctx context.Context, key string, limit int, period time.Duration, burst int) (*RateLimitResult, error) {
+	if burst <= 0 {
+		burst = limit
+	}
+
+	res, err := gcraScript.Run(ctx, GetClient().client, []string{key},
+		burst, limit, period.Seconds(), 1,
+	).Slice()
+	if err != nil {
+		return nil, err
+	}
+
+	retryAfter, _ := strconv.ParseFloat(res[2].(string), 64)
+	resetAfter, _ := strconv.ParseFloat(res[3].(string), 64)
+
+	return &RateLimitResult{
+		Allowed:    res[0].(int64) == 1,
+		Limit:      burst,
+		Remaining:  int(res[1].(int64)),
+		RetryAfter: time.Duration(retryAfter * float64(time.Second)),
+		ResetAfter: time.Duration(resetAfter * float64(time.Second)),
+	}, nil
+}
+
+// BuildKeyRateLimit -> app:ratelimit:auth:ip:1.2.3.4
+func BuildKeyRateLimit(policy string, subject string) string {
+	return BuildKey("ratelimit", policy, subject)
+}
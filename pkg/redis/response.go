@@ -0,0 +1,63 @@
+package redis
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/goccy/go-json"
+	"github.com/redis/go-redis/v9"
+)
+
+// ═══════════════════════════════════════════════════════════════════
+// HTTP RESPONSE CACHE
+// ═══════════════════════════════════════════════════════════════════
+// Tüm GET yanıtı tek key'de tutulur: app:resp:<route-hash>:<variant-hash>
+// Yanıt key'i, domain objeleriyle aynı dependency setlerine (BuildKeyDep) eklenir.
+// Böylece InvalidateEntity("blog", "1") çağrıldığında InvalidateByDependency
+// bu set'teki response key'lerini de siler; ayrıca bir invalidation yolu gerekmez.
+
+type CachedResponse struct {
+	Status       int                 `json:"status"`
+	Headers      map[string][]string `json:"headers"`
+	Body         []byte              `json:"body"`
+	ETag         string              `json:"etag"`
+	LastModified time.Time           `json:"lastModified"`
+}
+
+// GetResponse - Key yoksa ErrNotFound döner.
+func GetResponse(ctx context.Context, key string) (*CachedResponse, error) {
+	val, err := GetClient().client.Get(ctx, key).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var resp CachedResponse
+	if err := json.Unmarshal(val, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// SetResponse - Yanıtı yazar ve verilen dependency setlerine key'i ekler.
+func SetResponse(ctx context.Context, key string, resp *CachedResponse, expiration time.Duration, deps []Dependency) error {
+	data, err := json.Marshal(resp)
+	if err != nil {
+		return err
+	}
+
+	pipe := GetClient().client.Pipeline()
+	pipe.Set(ctx, key, data, expiration)
+
+	for _, dep := range deps {
+		depKey := BuildKeyDep(dep.Domain, dep.ID)
+		pipe.SAdd(ctx, depKey, key)
+		pipe.Expire(ctx, depKey, expiration)
+	}
+
+	_, err = pipe.Exec(ctx)
+	return err
+}
@@ -0,0 +1,61 @@
+package requestid
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// Header - İstek ID'sinin taşındığı header. CORS ExposeHeaders'ta da tanımlı.
+const Header = "X-Request-Id"
+
+// maxLength - Client'tan gelen ID bu uzunluktan büyükse yok sayılır (log injection koruması).
+const maxLength = 128
+
+type contextKey struct{}
+
+// NewContext - ID'yi context'e yazar. Repository, pkg/redis ve pkg/r2 çağrıları
+// c.Request.Context() aldığı için ID tüm katmanlara kendiliğinden taşınır.
+func NewContext(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, contextKey{}, id)
+}
+
+// FromContext - Context'te ID yoksa boş string döner.
+func FromContext(ctx context.Context) string {
+	if ctx == nil {
+		return ""
+	}
+	id, _ := ctx.Value(contextKey{}).(string)
+	return id
+}
+
+// Detach - Arka plan işleri (goroutine) için iptal edilmeyen yeni bir context'e ID'yi taşır.
+func Detach(ctx context.Context) context.Context {
+	return NewContext(context.Background(), FromContext(ctx))
+}
+
+// Generate - Zaman sıralı (UUIDv7) yeni bir ID üretir.
+func Generate() string {
+	id, err := uuid.NewV7()
+	if err != nil {
+		return uuid.NewString()
+	}
+	return id.String()
+}
+
+// IsValid - Client'ın gönderdiği ID'yi kabul etmeden önce kontrol eder.
+// Sadece harf, rakam, '-', '_', '.' ve ':' karakterlerine izin verilir.
+func IsValid(id string) bool {
+	if id == "" || len(id) > maxLength {
+		return false
+	}
+	for _, r := range id {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9':
+		case r == '-', r == '_', r == '.', r == ':':
+		default:
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,136 @@
+package resilience
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/okanay/go-template/pkg/logger"
+)
+
+type State string
+
+const (
+	StateClosed   State = "closed"    // Normal: çağrılar geçer, ardışık hatalar sayılır
+	StateOpen     State = "open"      // Bağımlılık çökmüş kabul edilir: çağrılar denenmeden ErrOpen döner
+	StateHalfOpen State = "half_open" // OpenTimeout doldu: sınırlı sayıda deneme çağrısı geçer
+)
+
+var ErrOpen = errors.New("circuit breaker is open")
+
+type BreakerConfig struct {
+	FailureThreshold int           // Bu kadar ardışık hata -> open. 0 ise 5
+	OpenTimeout      time.Duration // Open'da kalma süresi. 0 ise 30s
+	HalfOpenMaxCalls int           // Half-open'da aynı anda izin verilen deneme. 0 ise 1
+}
+
+// Breaker - Ardışık hata sayan basit circuit breaker.
+// Half-open'daki deneme başarılı olursa closed'a, başarısız olursa tekrar open'a geçer.
+type Breaker struct {
+	name string
+	cfg  BreakerConfig
+
+	mu               sync.Mutex
+	state            State
+	failures         int
+	openedAt         time.Time
+	halfOpenInFlight int
+}
+
+func NewBreaker(name string, cfg BreakerConfig) *Breaker {
+	if cfg.FailureThreshold <= 0 {
+		cfg.FailureThreshold = 5
+	}
+	if cfg.OpenTimeout <= 0 {
+		cfg.OpenTimeout = 30 * time.Second
+	}
+	if cfg.HalfOpenMaxCalls <= 0 {
+		cfg.HalfOpenMaxCalls = 1
+	}
+	return &Breaker{name: name, cfg: cfg, state: StateClosed}
+}
+
+// Allow - Çağrı yapılabilirse sonucu bildirmek için done fonksiyonu döner.
+// done(false) bağımlılık hatasını, done(true) başarıyı (veya bağımlılıkla ilgisiz hatayı) bildirir.
+func (b *Breaker) Allow() (done func(success bool), err error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.state == StateOpen {
+		if time.Since(b.openedAt) < b.cfg.OpenTimeout {
+			return nil, ErrOpen
+		}
+		b.transitionLocked(StateHalfOpen)
+	}
+
+	if b.state == StateHalfOpen {
+		if b.halfOpenInFlight >= b.cfg.HalfOpenMaxCalls {
+			return nil, ErrOpen
+		}
+		b.halfOpenInFlight++
+		return b.doneFunc(true), nil
+	}
+
+	return b.doneFunc(false), nil
+}
+
+func (b *Breaker) doneFunc(probe bool) func(bool) {
+	var once sync.Once
+	return func(success bool) {
+		once.Do(func() { b.record(probe, success) })
+	}
+}
+
+func (b *Breaker) record(probe, success bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if probe {
+		b.halfOpenInFlight--
+	}
+
+	if success {
+		b.failures = 0
+		if b.state == StateHalfOpen {
+			b.transitionLocked(StateClosed)
+		}
+		return
+	}
+
+	b.failures++
+	if b.state == StateHalfOpen || b.failures >= b.cfg.FailureThreshold {
+		b.transitionLocked(StateOpen)
+	}
+}
+
+func (b *Breaker) transitionLocked(to State) {
+	if b.state == to {
+		return
+	}
+	from := b.state
+	b.state = to
+
+	switch to {
+	case StateOpen:
+		b.openedAt = time.Now()
+		logger.Component("resilience").Warn("circuit breaker opened",
+			"dependency", b.name,
+			"from", string(from),
+			"failures", b.failures,
+			"retry_in", b.cfg.OpenTimeout.String(),
+		)
+	case StateClosed:
+		b.failures = 0
+		logger.Component("resilience").Info("circuit breaker closed", "dependency", b.name)
+	}
+}
+
+func (b *Breaker) State() State {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	// OpenTimeout dolduysa bir sonraki çağrı half-open olarak geçecek
+	if b.state == StateOpen && time.Since(b.openedAt) >= b.cfg.OpenTimeout {
+		return StateHalfOpen
+	}
+	return b.state
+}
@@ -0,0 +1,209 @@
+package resilience
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/okanay/go-template/pkg/loadshed"
+)
+
+// ═══════════════════════════════════════════════════════════════════
+// RESILIENCE - Dış bağımlılık çağrıları (R2, OAuth, Turnstile, webhook)
+// ═══════════════════════════════════════════════════════════════════
+// Her bağımlılık için tek bir Dependency oluşturulur; üç katman sırayla uygulanır:
+//
+//  1. Bulkhead  - Bağımlılığa aynı anda giden çağrı sayısı sınırlı (pkg/loadshed).
+//     Yavaşlayan bir servis tüm goroutine'leri ve DB bağlantılarını kilitleyemez.
+//  2. Breaker   - Ardışık hatalardan sonra çağrılar denenmeden ErrOpen ile reddedilir.
+//  3. Retry     - Geçici hatalar backoff + jitter ile tekrarlanır. Idempotent olmayan
+//     çağrılar (DoOnce) sadece istek karşıya hiç ulaşmadıysa tekrarlanır.
+//
+//	r2Dep := resilience.New(resilience.Config{Name: "r2", MaxConcurrent: 20})
+//	err := r2Dep.Do(ctx, func(ctx context.Context) error { ... })
+//
+// Oluşturulan tüm Dependency'lerin durumu Snapshot() ile health check'e ve
+// admin endpoint'ine verilir.
+
+var ErrBulkheadFull = errors.New("bulkhead is full")
+
+type Config struct {
+	Name    string
+	Retry   RetryPolicy
+	Breaker BreakerConfig
+
+	MaxConcurrent int           // 0 ise bulkhead yok
+	MaxQueued     int           // MaxConcurrent doluyken bekleyebilecek çağrı
+	MaxWait       time.Duration // Kuyrukta maksimum bekleme, 0 ise 100ms
+
+	// IsFailure - Hangi hataların breaker'a "bağımlılık hatası" olarak yazılacağı.
+	// nil ise Retry.Retryable'ın kabul ettiği hatalar ve timeout'lar; 404 gibi
+	// iş mantığı hataları breaker'ı açmamalıdır.
+	IsFailure func(error) bool
+}
+
+type Stats struct {
+	Name     string `json:"name"`
+	State    State  `json:"state"`
+	InFlight int    `json:"inFlight"`
+	Calls    uint64 `json:"calls"`
+	Failures uint64 `json:"failures"`
+	Retries  uint64 `json:"retries"`
+	Rejected uint64 `json:"rejected"` // Breaker açık veya bulkhead dolu
+}
+
+type Dependency struct {
+	cfg      Config
+	breaker  *Breaker
+	bulkhead *loadshed.Limiter
+
+	calls    atomic.Uint64
+	failures atomic.Uint64
+	retries  atomic.Uint64
+	rejected atomic.Uint64
+}
+
+var (
+	registryMu sync.RWMutex
+	registry   = map[string]*Dependency{}
+)
+
+// New - Dependency oluşturur ve Snapshot için kaydeder. Aynı isimle tekrar çağrılırsa eskisinin yerini alır.
+func New(cfg Config) *Dependency {
+	cfg.Retry = cfg.Retry.withDefaults()
+	if cfg.IsFailure == nil {
+		retryable := cfg.Retry.Retryable
+		cfg.IsFailure = func(err error) bool {
+			return retryable(err) || errors.Is(err, context.DeadlineExceeded)
+		}
+	}
+
+	d := &Dependency{
+		cfg:     cfg,
+		breaker: NewBreaker(cfg.Name, cfg.Breaker),
+	}
+	if cfg.MaxConcurrent > 0 {
+		d.bulkhead = loadshed.New(loadshed.Config{
+			Name:         cfg.Name,
+			Limit:        cfg.MaxConcurrent,
+			QueueSize:    cfg.MaxQueued,
+			QueueTimeout: cfg.MaxWait,
+		})
+	}
+
+	registryMu.Lock()
+	registry[cfg.Name] = d
+	registryMu.Unlock()
+	return d
+}
+
+// Do - Idempotent çağrılar için (GET, HEAD, DELETE, PUT): geçici hatalarda tekrar denenir.
+func (d *Dependency) Do(ctx context.Context, fn func(ctx context.Context) error) error {
+	return d.run(ctx, true, fn)
+}
+
+// DoOnce - Idempotent olmayan çağrılar için (ödeme, tek kullanımlık token doğrulama):
+// sadece IsNotSent hatalarında tekrar denenir, karşı tarafta en fazla bir kez çalışır.
+func (d *Dependency) DoOnce(ctx context.Context, fn func(ctx context.Context) error) error {
+	return d.run(ctx, false, fn)
+}
+
+func (d *Dependency) run(ctx context.Context, idempotent bool, fn func(ctx context.Context) error) error {
+	var err error
+	for attempt := 0; attempt < d.cfg.Retry.MaxAttempts; attempt++ {
+		if attempt > 0 {
+			d.retries.Add(1)
+			if sleepErr := sleep(ctx, d.cfg.Retry.Backoff(attempt-1)); sleepErr != nil {
+				return err
+			}
+		}
+
+		err = d.attempt(ctx, fn)
+		if err == nil {
+			return nil
+		}
+
+		// Breaker açık / bulkhead dolu: beklemek sonucu değiştirmez, hemen dön
+		if errors.Is(err, ErrOpen) || errors.Is(err, ErrBulkheadFull) {
+			return err
+		}
+		if idempotent && !d.cfg.Retry.Retryable(err) {
+			return err
+		}
+		if !idempotent && !IsNotSent(err) {
+			return err
+		}
+	}
+	return err
+}
+
+func (d *Dependency) attempt(ctx context.Context, fn func(ctx context.Context) error) error {
+	if d.bulkhead != nil {
+		release, err := d.bulkhead.Acquire(ctx)
+		if err != nil {
+			if errors.Is(err, loadshed.ErrShed) {
+				d.rejected.Add(1)
+				return ErrBulkheadFull
+			}
+			return err
+		}
+		defer release()
+	}
+
+	done, err := d.breaker.Allow()
+	if err != nil {
+		d.rejected.Add(1)
+		return err
+	}
+
+	d.calls.Add(1)
+	err = fn(ctx)
+
+	failed := err != nil && d.cfg.IsFailure(err)
+	if failed {
+		d.failures.Add(1)
+	}
+	done(!failed)
+	return err
+}
+
+func (d *Dependency) Stats() Stats {
+	stats := Stats{
+		Name:     d.cfg.Name,
+		State:    d.breaker.State(),
+		Calls:    d.calls.Load(),
+		Failures: d.failures.Load(),
+		Retries:  d.retries.Load(),
+		Rejected: d.rejected.Load(),
+	}
+	if d.bulkhead != nil {
+		stats.InFlight = d.bulkhead.Stats().InFlight
+	}
+	return stats
+}
+
+// Snapshot - Kayıtlı tüm bağımlılıkların durumu, isme göre sıralı.
+func Snapshot() []Stats {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+
+	stats := make([]Stats, 0, len(registry))
+	for _, d := range registry {
+		stats = append(stats, d.Stats())
+	}
+	sort.Slice(stats, func(i, j int) bool { return stats[i].Name < stats[j].Name })
+	return stats
+}
+
+// Healthy - Hiçbir breaker open değilse true.
+func Healthy() bool {
+	for _, s := range Snapshot() {
+		if s.State == StateOpen {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,128 @@
+package resilience
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math/rand/v2"
+	"net"
+	"syscall"
+	"time"
+)
+
+// RetryPolicy - Exponential backoff + full jitter.
+// n. denemeden önce [0, min(MaxDelay, BaseDelay*2^n)) arasında rastgele beklenir;
+// aynı anda hata alan instance'lar bağımlılığa aynı anda tekrar yüklenmez.
+type RetryPolicy struct {
+	MaxAttempts int           // İlk deneme dahil. 0 ise 3, 1 ise retry yok
+	BaseDelay   time.Duration // 0 ise 100ms
+	MaxDelay    time.Duration // 0 ise 2s
+
+	// Retryable - Hatanın tekrar denemeye değer olup olmadığı. nil ise IsTransient.
+	// Sadece idempotent çağrılarda kullanılır; idempotent olmayanlar yalnızca IsNotSent hatalarında tekrarlanır.
+	Retryable func(error) bool
+}
+
+func (p RetryPolicy) withDefaults() RetryPolicy {
+	if p.MaxAttempts <= 0 {
+		p.MaxAttempts = 3
+	}
+	if p.BaseDelay <= 0 {
+		p.BaseDelay = 100 * time.Millisecond
+	}
+	if p.MaxDelay <= 0 {
+		p.MaxDelay = 2 * time.Second
+	}
+	if p.Retryable == nil {
+		p.Retryable = IsTransient
+	}
+	return p
+}
+
+// Backoff - attempt 0'dan başlar (ilk retry'dan önceki bekleme).
+func (p RetryPolicy) Backoff(attempt int) time.Duration {
+	p = p.withDefaults()
+	ceiling := p.MaxDelay
+	if attempt < 30 {
+		ceiling = min(p.MaxDelay, p.BaseDelay<<attempt)
+	}
+	return rand.N(ceiling) + 1
+}
+
+// ─── Hata sınıflandırma ──────────────────────────────────────────
+
+type permanentError struct{ err error }
+
+func (e permanentError) Error() string { return e.err.Error() }
+func (e permanentError) Unwrap() error { return e.err }
+
+// Permanent - fn'in döndüğü hatayı "tekrar deneme" olarak işaretler (4xx, doğrulama hatası vb.).
+func Permanent(err error) error {
+	if err == nil {
+		return nil
+	}
+	return permanentError{err}
+}
+
+type notSentError struct{ err error }
+
+func (e notSentError) Error() string { return e.err.Error() }
+func (e notSentError) Unwrap() error { return e.err }
+
+// NotSent - İsteğin karşı tarafa hiç ulaşmadığı bilinen hatalar için. Bu hatalar
+// idempotent olmayan çağrılarda (ödeme, tek kullanımlık token doğrulama) da güvenle tekrarlanır.
+func NotSent(err error) error {
+	if err == nil {
+		return nil
+	}
+	return notSentError{err}
+}
+
+// IsNotSent - NotSent ile işaretlenmiş hatalar ve bağlantı kurulamayan (dial) ağ hataları.
+func IsNotSent(err error) bool {
+	var ns notSentError
+	if errors.As(err, &ns) {
+		return true
+	}
+	var opErr *net.OpError
+	return errors.As(err, &opErr) && opErr.Op == "dial"
+}
+
+// IsTransient - Varsayılan sınıflandırıcı: timeout, bağlantı kopması ve DNS geçici hataları.
+// Context iptali ve Permanent hatalar asla tekrarlanmaz.
+func IsTransient(err error) bool {
+	if err == nil || isPermanent(err) || errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	if IsNotSent(err) {
+		return true
+	}
+	if errors.Is(err, io.ErrUnexpectedEOF) || errors.Is(err, io.EOF) ||
+		errors.Is(err, syscall.ECONNRESET) || errors.Is(err, syscall.ECONNREFUSED) || errors.Is(err, syscall.EPIPE) {
+		return true
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return true
+	}
+	var dnsErr *net.DNSError
+	return errors.As(err, &dnsErr) && dnsErr.Temporary()
+}
+
+func isPermanent(err error) bool {
+	var pe permanentError
+	return errors.As(err, &pe)
+}
+
+// sleep - ctx iptal edilirse erken döner.
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
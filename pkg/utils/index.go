@@ -3,7 +3,7 @@ package utils
 import (
 	"crypto/rand"
 	"encoding/json"
-	"log"
+	"fmt"
 	"math/big"
 	"os"
 	"regexp"
@@ -21,7 +21,8 @@ func GenerateRandomString(length int) string {
 		num, err := rand.Int(rand.Reader, big.NewInt(int64(len(Alphabet))))
 		if err != nil {
 			// crypto/rand hatası kritik bir OS sorunudur, panic makuldür.
-			log.Panicf("crypto/rand failed: %v", err)
+			// HTTP isteklerinde middleware.Recovery yakalar, stack ile loglar ve 500 döner.
+			panic(fmt.Errorf("crypto/rand failed: %w", err))
 		}
 		b[i] = Alphabet[num.Int64()]
 	}
@@ -36,7 +37,7 @@ func GenerateRandomInt(min, max int) int {
 	diff := max - min
 	nBig, err := rand.Int(rand.Reader, big.NewInt(int64(diff)))
 	if err != nil {
-		log.Panicf("crypto/rand failed: %v", err)
+		panic(fmt.Errorf("crypto/rand failed: %w", err))
 	}
 	return int(nBig.Int64()) + min
 }
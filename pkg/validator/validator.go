@@ -2,7 +2,8 @@ package validation
 
 import (
 	"encoding/json"
-	"fmt"
+	"errors"
+	"net/http"
 	"path/filepath"
 	"reflect"
 	"regexp"
@@ -12,6 +13,7 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/gin-gonic/gin/binding"
 	"github.com/go-playground/validator/v10"
+	"github.com/okanay/go-template/pkg/i18n"
 )
 
 // BindType enum
@@ -80,7 +82,7 @@ func New() *Validator {
 
 	// Hata mesajlarında struct field adı yerine JSON tag'ini kullan
 	v.RegisterTagNameFunc(func(fld reflect.StructField) string {
-		tagTypes := []string{"json", "form", "query", "uri"}
+		tagTypes := []string{"json", "form", "query", "uri", "env"}
 		for _, tagType := range tagTypes {
 			name := strings.SplitN(fld.Tag.Get(tagType), ",", 2)[0]
 			if name != "" && name != "-" {
@@ -96,6 +98,7 @@ func New() *Validator {
 // BindAndValidate - Hem bind eder, hem validate eder
 func (v *Validator) BindAndValidate(c *gin.Context, req any, bindType BindType) []Violation {
 	var err error
+	lang := i18n.FromContext(c.Request.Context())
 
 	// 1. Binding (Veriyi struct'a doldur)
 	switch bindType {
@@ -111,16 +114,26 @@ func (v *Validator) BindAndValidate(c *gin.Context, req any, bindType BindType)
 		err = c.ShouldBindJSON(req)
 	}
 
+	// Body, middleware.Limits'in MaxBytesReader sınırını aştı (chunked upload vb.)
+	var maxBytesErr *http.MaxBytesError
+	if errors.As(err, &maxBytesErr) {
+		return []Violation{{
+			Field:   "payload",
+			Tag:     "max_bytes",
+			Message: i18n.Sprintf(lang, "Request body must not exceed %d bytes", maxBytesErr.Limit),
+		}}
+	}
+
 	if err != nil {
 		return []Violation{{
 			Field:   "payload",
 			Tag:     "binding_error",
-			Message: fmt.Sprintf("Invalid data format: %v", err),
+			Message: i18n.Sprintf(lang, "Invalid data format: %v", err),
 		}}
 	}
 
 	if err := v.validate.Struct(req); err != nil {
-		return v.formatErrors(err)
+		return v.formatErrors(err, lang)
 	}
 
 	v.sanitizeRequest(req)
@@ -128,97 +141,108 @@ func (v *Validator) BindAndValidate(c *gin.Context, req any, bindType BindType)
 	return nil
 }
 
-// customErrorMessage - Translates error messages to English
-func (v *Validator) customErrorMessage(e validator.FieldError) string {
+// Validate - HTTP isteği dışındaki struct'ları (config, cron girdileri) doğrular.
+// Mesajlar varsayılan dilde (i18n.Default) döner.
+func (v *Validator) Validate(req any) []Violation {
+	if err := v.validate.Struct(req); err != nil {
+		return v.formatErrors(err, i18n.Default)
+	}
+	return nil
+}
+
+// customErrorMessage - Hata mesajını istenen dilde döner (pkg/i18n kataloğu)
+func (v *Validator) customErrorMessage(e validator.FieldError, lang string) string {
 	field := e.Field()
 	tag := e.Tag()
 	param := e.Param()
 
 	switch tag {
 	case "required":
-		return fmt.Sprintf("The %s field is required.", field)
+		return i18n.Sprintf(lang, "The %s field is required.", field)
 	case "required_if":
-		return fmt.Sprintf("The %s field is required under certain conditions.", field)
+		return i18n.Sprintf(lang, "The %s field is required under certain conditions.", field)
+	case "required_without":
+		return i18n.Sprintf(lang, "The %s field is required when %s is not present.", field, param)
 	case "file_ext":
-		return fmt.Sprintf("The %s field only accepts the following file types: %s", e.Field(), e.Param())
+		return i18n.Sprintf(lang, "The %s field only accepts the following file types: %s", e.Field(), e.Param())
 	case "email":
-		return fmt.Sprintf("The %s field must be a valid email address.", field)
+		return i18n.Sprintf(lang, "The %s field must be a valid email address.", field)
 	case "min":
 		if e.Kind() == reflect.String {
-			return fmt.Sprintf("The %s field must be at least %s characters.", field, param)
+			return i18n.Sprintf(lang, "The %s field must be at least %s characters.", field, param)
 		}
-		return fmt.Sprintf("The minimum value for the %s field is %s.", field, param)
+		return i18n.Sprintf(lang, "The minimum value for the %s field is %s.", field, param)
 	case "max":
 		if e.Kind() == reflect.String {
-			return fmt.Sprintf("The %s field must not be greater than %s characters.", field, param)
+			return i18n.Sprintf(lang, "The %s field must not be greater than %s characters.", field, param)
 		}
-		return fmt.Sprintf("The maximum value for the %s field is %s.", field, param)
+		return i18n.Sprintf(lang, "The maximum value for the %s field is %s.", field, param)
 	case "len":
 		if e.Kind() == reflect.String {
-			return fmt.Sprintf("The %s field must be exactly %s characters.", field, param)
+			return i18n.Sprintf(lang, "The %s field must be exactly %s characters.", field, param)
 		}
-		return fmt.Sprintf("The %s field must contain exactly %s elements.", field, param)
+		return i18n.Sprintf(lang, "The %s field must contain exactly %s elements.", field, param)
 	case "gte":
 		if e.Kind() == reflect.String {
-			return fmt.Sprintf("The %s field must be at least %s characters.", field, param)
+			return i18n.Sprintf(lang, "The %s field must be at least %s characters.", field, param)
 		}
-		return fmt.Sprintf("The %s field must be greater than or equal to %s.", field, param)
+		return i18n.Sprintf(lang, "The %s field must be greater than or equal to %s.", field, param)
 	case "lte":
 		if e.Kind() == reflect.String {
-			return fmt.Sprintf("The %s field must not be greater than %s characters.", field, param)
+			return i18n.Sprintf(lang, "The %s field must not be greater than %s characters.", field, param)
 		}
-		return fmt.Sprintf("The %s field must be less than or equal to %s.", field, param)
+		return i18n.Sprintf(lang, "The %s field must be less than or equal to %s.", field, param)
 	case "gt":
-		return fmt.Sprintf("The %s field must be greater than %s.", field, param)
+		return i18n.Sprintf(lang, "The %s field must be greater than %s.", field, param)
 	case "lt":
-		return fmt.Sprintf("The %s field must be less than %s.", field, param)
+		return i18n.Sprintf(lang, "The %s field must be less than %s.", field, param)
 	case "oneof":
-		return fmt.Sprintf("The %s field must be one of the following values: [%s].", field, param)
+		return i18n.Sprintf(lang, "The %s field must be one of the following values: [%s].", field, param)
 	case "alpha":
-		return fmt.Sprintf("The %s field must contain only alphabetic characters.", field)
+		return i18n.Sprintf(lang, "The %s field must contain only alphabetic characters.", field)
 	case "alphanum":
-		return fmt.Sprintf("The %s field must contain only alphanumeric characters.", field)
+		return i18n.Sprintf(lang, "The %s field must contain only alphanumeric characters.", field)
 	case "numeric":
-		return fmt.Sprintf("The %s field must contain only numeric characters.", field)
+		return i18n.Sprintf(lang, "The %s field must contain only numeric characters.", field)
 	case "url":
-		return fmt.Sprintf("The %s field must be a valid URL.", field)
+		return i18n.Sprintf(lang, "The %s field must be a valid URL.", field)
 	case "uri":
-		return fmt.Sprintf("The %s field must be a valid URI.", field)
+		return i18n.Sprintf(lang, "The %s field must be a valid URI.", field)
 	case "uuid":
-		return fmt.Sprintf("The %s field must be a valid UUID.", field)
+		return i18n.Sprintf(lang, "The %s field must be a valid UUID.", field)
 	case "slug_format":
-		return fmt.Sprintf("The %s field must be a valid slug format (lowercase, number, and hyphen).", field)
+		return i18n.Sprintf(lang, "The %s field must be a valid slug format (lowercase, number, and hyphen).", field)
 	case "datetime":
-		return fmt.Sprintf("The %s field must be a valid date-time format.", field)
+		return i18n.Sprintf(lang, "The %s field must be a valid date-time format.", field)
 	case "contains":
-		return fmt.Sprintf("The %s field must contain '%s'.", field, param)
+		return i18n.Sprintf(lang, "The %s field must contain '%s'.", field, param)
 	case "containsany":
-		return fmt.Sprintf("The %s field must contain at least one of the following characters: %s.", field, param)
+		return i18n.Sprintf(lang, "The %s field must contain at least one of the following characters: %s.", field, param)
 	case "excludes":
-		return fmt.Sprintf("The %s field must not contain '%s'.", field, param)
+		return i18n.Sprintf(lang, "The %s field must not contain '%s'.", field, param)
 	case "startswith":
-		return fmt.Sprintf("The %s field must start with '%s'.", field, param)
+		return i18n.Sprintf(lang, "The %s field must start with '%s'.", field, param)
 	case "endswith":
-		return fmt.Sprintf("The %s field must end with '%s'.", field, param)
+		return i18n.Sprintf(lang, "The %s field must end with '%s'.", field, param)
 	case "eqfield":
-		return fmt.Sprintf("The %s field must be the same as the %s field.", field, param)
+		return i18n.Sprintf(lang, "The %s field must be the same as the %s field.", field, param)
 	case "nefield":
-		return fmt.Sprintf("The %s field must be different from the %s field.", field, param)
+		return i18n.Sprintf(lang, "The %s field must be different from the %s field.", field, param)
 	case "json_format":
-		return fmt.Sprintf("The %s field must be a valid JSON format.", field)
+		return i18n.Sprintf(lang, "The %s field must be a valid JSON format.", field)
 	default:
-		return fmt.Sprintf("The '%s' rule for the %s field is not satisfied.", tag, field)
+		return i18n.Sprintf(lang, "The '%s' rule for the %s field is not satisfied.", tag, field)
 	}
 }
 
 // formatErrors - Validasyon hatalarını bizim formatımıza çevirir
-func (v *Validator) formatErrors(err error) []Violation {
+func (v *Validator) formatErrors(err error, lang string) []Violation {
 	var violations []Violation
 	for _, e := range err.(validator.ValidationErrors) {
 		violations = append(violations, Violation{
 			Field:   e.Field(),
 			Tag:     e.Tag(),
-			Message: v.customErrorMessage(e),
+			Message: v.customErrorMessage(e, lang),
 		})
 	}
 	return violations
@@ -0,0 +1,41 @@
+package webhook
+
+import (
+	"encoding/hex"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// GitHub - "X-Hub-Signature-256: sha256=<hex>" formatı. İmzalanan metin body'nin kendisidir.
+// Formatta timestamp yoktur; replay koruması imzalı body'nin özetiyle yapılır. X-GitHub-Delivery
+// imzaya dahil olmadığı için değiştirilebilir, nonce olarak kullanılmaz.
+type GitHub struct {
+	Header string // Boşsa "X-Hub-Signature-256"
+}
+
+func (g GitHub) Name() string {
+	return "github"
+}
+
+func (g GitHub) Verify(header http.Header, body []byte, secrets [][]byte, now time.Time, tolerance time.Duration) (*Delivery, error) {
+	name := g.Header
+	if name == "" {
+		name = "X-Hub-Signature-256"
+	}
+
+	value, ok := strings.CutPrefix(header.Get(name), "sha256=")
+	if !ok {
+		return nil, ErrMissingSignature
+	}
+	sig, err := hex.DecodeString(value)
+	if err != nil {
+		return nil, ErrMissingSignature
+	}
+
+	if !matchAny([][]byte{sig}, secrets, body) {
+		return nil, ErrInvalidSignature
+	}
+
+	return &Delivery{ID: deliveryID(body)}, nil
+}
@@ -0,0 +1,68 @@
+package webhook
+
+import (
+	"encoding/hex"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Stripe - "Stripe-Signature: t=1700000000,v1=<hex>,v1=<hex>" formatı.
+// İmzalanan metin "<t>.<body>"dir; birden fazla v1 değeri secret rotation sırasında gelir.
+// Aynı formatı kullanan partnerler için header adı değiştirilebilir.
+type Stripe struct {
+	Header string // Boşsa "Stripe-Signature"
+}
+
+func (s Stripe) Name() string {
+	return "stripe"
+}
+
+func (s Stripe) Verify(header http.Header, body []byte, secrets [][]byte, now time.Time, tolerance time.Duration) (*Delivery, error) {
+	name := s.Header
+	if name == "" {
+		name = "Stripe-Signature"
+	}
+
+	value := header.Get(name)
+	if value == "" {
+		return nil, ErrMissingSignature
+	}
+
+	var timestamp string
+	var signatures [][]byte
+	for _, part := range strings.Split(value, ",") {
+		key, val, ok := strings.Cut(strings.TrimSpace(part), "=")
+		if !ok {
+			continue
+		}
+		switch key {
+		case "t":
+			timestamp = val
+		case "v1":
+			if sig, err := hex.DecodeString(val); err == nil {
+				signatures = append(signatures, sig)
+			}
+		}
+	}
+
+	unix, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil || len(signatures) == 0 {
+		return nil, ErrMissingSignature
+	}
+
+	payload := append([]byte(timestamp+"."), body...)
+	if !matchAny(signatures, secrets, payload) {
+		return nil, ErrInvalidSignature
+	}
+
+	// Timestamp imzanın içinde olduğu için imza doğrulandıktan sonra kontrol edilir
+	ts := time.Unix(unix, 0)
+	if !withinTolerance(ts, now, tolerance) {
+		return nil, ErrTimestampExpired
+	}
+
+	// Stripe her denemede yeni t ile imzalar; "<t>.<body>" her teslimat için benzersizdir
+	return &Delivery{ID: deliveryID(payload), Timestamp: ts}, nil
+}
@@ -0,0 +1,96 @@
+package webhook
+
+import (
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestStripeVerifyDeliveryID(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	ts := strconv.FormatInt(now.Unix(), 10)
+	body := []byte(`{"id":"evt_1","type":"invoice.paid"}`)
+	oldSecret, newSecret := []byte("whsec_old"), []byte("whsec_new")
+	secrets := [][]byte{oldSecret, newSecret}
+
+	sign := func(secret []byte) string {
+		return hex.EncodeToString(Sign(secret, []byte(ts+"."+string(body))))
+	}
+	valid, rotated := sign(newSecret), sign(oldSecret)
+	junk := "00"
+	forged := hex.EncodeToString(Sign([]byte("attacker"), []byte(ts+"."+string(body))))
+
+	tests := []struct {
+		name    string
+		header  string
+		wantErr error
+	}{
+		{name: "single signature", header: "t=" + ts + ",v1=" + valid},
+		{name: "junk before valid", header: "t=" + ts + ",v1=" + junk + ",v1=" + valid},
+		{name: "junk after valid", header: "t=" + ts + ",v1=" + valid + ",v1=" + junk},
+		{name: "several junk values", header: "t=" + ts + ",v1=" + junk + ",v1=ff,v1=" + forged + ",v1=" + valid},
+		{name: "rotation order", header: "t=" + ts + ",v1=" + rotated + ",v1=" + valid},
+		{name: "rotation reversed", header: "t=" + ts + ",v1=" + valid + ",v1=" + rotated},
+		{name: "old secret only", header: "t=" + ts + ",v1=" + rotated},
+		{name: "timestamp last", header: "v1=" + junk + ",v1=" + valid + ",t=" + ts},
+		{name: "unknown scheme ignored", header: "t=" + ts + ",v0=" + junk + ",v1=" + valid},
+		{name: "junk only", header: "t=" + ts + ",v1=" + junk, wantErr: ErrInvalidSignature},
+		{name: "forged only", header: "t=" + ts + ",v1=" + forged, wantErr: ErrInvalidSignature},
+		{name: "missing timestamp", header: "v1=" + valid, wantErr: ErrMissingSignature},
+		{name: "missing signature", header: "t=" + ts, wantErr: ErrMissingSignature},
+	}
+
+	var wantID string
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			header := http.Header{}
+			header.Set("Stripe-Signature", tt.header)
+
+			delivery, err := Stripe{}.Verify(header, body, secrets, now, 5*time.Minute)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("err = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			// Aynı teslimat header'ı nasıl değiştirilirse değiştirilsin aynı nonce'u üretmeli
+			if wantID == "" {
+				wantID = delivery.ID
+			}
+			if delivery.ID != wantID {
+				t.Fatalf("delivery ID = %q, want %q", delivery.ID, wantID)
+			}
+			if !delivery.Timestamp.Equal(now) {
+				t.Fatalf("timestamp = %v, want %v", delivery.Timestamp, now)
+			}
+		})
+	}
+}
+
+func TestStripeVerifyNewTimestampIsNewDelivery(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	body := []byte(`{"id":"evt_1"}`)
+	secret := []byte("whsec")
+
+	ids := map[string]bool{}
+	for _, unix := range []int64{now.Unix(), now.Unix() + 60} {
+		ts := strconv.FormatInt(unix, 10)
+		header := http.Header{}
+		header.Set("Stripe-Signature", "t="+ts+",v1="+hex.EncodeToString(Sign(secret, []byte(ts+"."+string(body)))))
+		delivery, err := Stripe{}.Verify(header, body, [][]byte{secret}, now, 5*time.Minute)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		ids[delivery.ID] = true
+	}
+	if len(ids) != 2 {
+		t.Fatalf("retries signed with a new timestamp must get distinct delivery IDs")
+	}
+}
@@ -0,0 +1,89 @@
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+var (
+	ErrMissingSignature = errors.New("webhook signature header is missing")
+	ErrInvalidSignature = errors.New("webhook signature does not match")
+	ErrTimestampExpired = errors.New("webhook timestamp is outside the tolerance")
+	ErrMissingSecret    = errors.New("webhook secret is not set")
+)
+
+// Scheme, bir partnerin imza formatı. Stripe ve GitHub hazır gelir; farklı bir format
+// (örn: storage partnerinin X-Signature header'ı) eklemek için bu arayüzü uygulamak yeterli.
+type Scheme interface {
+	// Name - Log ve nonce key'lerinde kullanılır: "stripe", "github"
+	Name() string
+	// Verify - İmzayı secret'lardan herhangi biriyle doğrular (rotation sırasında eski ve yeni secret
+	// birlikte geçerlidir). Timestamp içeren formatlar tolerance dışındaysa ErrTimestampExpired döner.
+	Verify(header http.Header, body []byte, secrets [][]byte, now time.Time, tolerance time.Duration) (*Delivery, error)
+}
+
+// Delivery, doğrulanmış bir webhook'un kimliği.
+type Delivery struct {
+	// ID - Replay koruması için benzersiz değer (delivery ID veya imza). Aynı ID ikinci kez kabul edilmez.
+	ID string
+	// Timestamp - İmzalı zaman damgası; formatta yoksa sıfır değer.
+	Timestamp time.Time
+}
+
+// Sign - HMAC-SHA256(secret, payload). Scheme implementasyonları ve testler için.
+func Sign(secret, payload []byte) []byte {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write(payload)
+	return mac.Sum(nil)
+}
+
+// matchAny - Sabit zamanlı karşılaştırma; imzanın hangi byte'ta ayrıldığı süreden anlaşılmaz.
+func matchAny(signatures [][]byte, secrets [][]byte, payload []byte) bool {
+	for _, secret := range secrets {
+		expected := Sign(secret, payload)
+		for _, sig := range signatures {
+			if hmac.Equal(sig, expected) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// deliveryID - İmzalanan içeriğin özeti. İmza değerlerinden türetilmez: header'a eklenen sahte
+// imzalar, imzaların sırası veya rotation sırasında farklı secret ile imzalanması ID'yi değiştirmez.
+func deliveryID(payload []byte) string {
+	sum := sha256.Sum256(payload)
+	return hex.EncodeToString(sum[:])
+}
+
+func withinTolerance(ts, now time.Time, tolerance time.Duration) bool {
+	if tolerance <= 0 {
+		return true
+	}
+	diff := now.Sub(ts)
+	if diff < 0 {
+		diff = -diff
+	}
+	return diff <= tolerance
+}
+
+// RawBodyKey - middleware.Webhook doğrulanan ham body'yi gin context'ine bu key ile yazar.
+const RawBodyKey = "webhookRawBody"
+
+// RawBody - İmzası doğrulanmış ham body. c.Request.Body de aynı içerikle tekrar okunabilir;
+// ancak imza byte'lara bağlı olduğu için partnere geri doğrulama yapan handler'lar bunu kullanmalıdır.
+func RawBody(c *gin.Context) []byte {
+	if v, ok := c.Get(RawBodyKey); ok {
+		if body, ok := v.([]byte); ok {
+			return body
+		}
+	}
+	return nil
+}